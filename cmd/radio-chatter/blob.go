@@ -0,0 +1,216 @@
+package main
+
+import (
+	"os"
+
+	radiochatter "github.com/Michael-F-Bryan/radio-chatter/pkg"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/encrypted_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mirrored_storage"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func blobCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blob",
+		Short: "Maintenance operations for blob storage",
+	}
+
+	registerDatabaseFlags(cmd.PersistentFlags())
+	registerStorageFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(blobGCCmd(), blobVerifyCmd(), blobRekeyCmd(), blobReconcileCmd(), blobExpireCmd())
+
+	return cmd
+}
+
+func blobGCCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Delete blobs that are no longer referenced by the database",
+		Run:   blobGC,
+	}
+
+	cmd.Flags().Bool("dry-run", false, "Report which blobs would be deleted without deleting them")
+	cmd.Flags().Duration("grace-period", radiochatter.DefaultGCGracePeriod, "Only delete unreferenced blobs older than this")
+
+	return cmd
+}
+
+func blobGC(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	logger := zap.L()
+	cfg := GetConfig(ctx)
+	db := setupDatabase(ctx, logger, cfg)
+	storage := setupStorage(ctx, logger, cfg.Storage)
+	defer storage.Close()
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	gracePeriod, _ := cmd.Flags().GetDuration("grace-period")
+
+	report, err := radiochatter.CollectGarbage(ctx, logger.Named("gc"), db, storage, gracePeriod, dryRun)
+	if err != nil {
+		logger.Fatal("Garbage collection failed", zap.Error(err))
+	}
+
+	if err := cfg.Format().Print(os.Stdout, report); err != nil {
+		logger.Fatal("Unable to print the report", zap.Any("report", report), zap.Error(err))
+	}
+}
+
+func blobVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Check that stored blobs aren't corrupt and that every referenced blob exists",
+		Run:   blobVerify,
+	}
+
+	cmd.Flags().String("quarantine", "", "Move corrupt blobs out of storage and into this directory")
+	cmd.Flags().String("state", "", "Save progress to this file so an interrupted run can be resumed")
+	cmd.Flags().Int64("rate-limit", 0, "The maximum number of bytes per second to read from storage (0 for unlimited)")
+
+	return cmd
+}
+
+func blobVerify(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	logger := zap.L()
+	cfg := GetConfig(ctx)
+	db := setupDatabase(ctx, logger, cfg)
+	storage := setupStorage(ctx, logger, cfg.Storage)
+	defer storage.Close()
+
+	var opts radiochatter.VerifyOptions
+	opts.QuarantineDir, _ = cmd.Flags().GetString("quarantine")
+	opts.StateFile, _ = cmd.Flags().GetString("state")
+	opts.MaxBytesPerSecond, _ = cmd.Flags().GetInt64("rate-limit")
+
+	report, err := radiochatter.VerifyBlobs(ctx, logger.Named("verify"), db, storage, opts)
+	if err != nil {
+		logger.Fatal("Verification failed", zap.Error(err))
+	}
+
+	if err := cfg.Format().Print(os.Stdout, report); err != nil {
+		logger.Fatal("Unable to print the report", zap.Any("report", report), zap.Error(err))
+	}
+
+	if len(report.Corrupt) > 0 || len(report.Missing) > 0 {
+		os.Exit(1)
+	}
+}
+
+func blobRekeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rekey",
+		Short: "Re-encrypt blobs that aren't encrypted with the current key",
+		Long: `Re-encrypt blobs that aren't encrypted with the current key.
+
+This also encrypts any blobs that were saved before encryption was enabled.
+Once it has completed, old keys can be removed from --blob-encryption-keys.`,
+		Run: blobRekey,
+	}
+
+	cmd.Flags().Bool("dry-run", false, "Report which blobs would be re-encrypted without changing them")
+
+	return cmd
+}
+
+func blobRekey(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	logger := zap.L()
+	cfg := GetConfig(ctx)
+	storage := setupStorage(ctx, logger, cfg.Storage)
+	defer storage.Close()
+
+	encrypted, ok := unwrapStorage[*encrypted_storage.EncryptedStorage](storage)
+	if !ok {
+		logger.Fatal("Blob encryption isn't enabled. Did you forget to set --blob-encryption-keys?")
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	report, err := encrypted.Rekey(ctx, dryRun)
+	if err != nil {
+		logger.Fatal("Re-encryption failed", zap.Error(err))
+	}
+
+	if err := cfg.Format().Print(os.Stdout, report); err != nil {
+		logger.Fatal("Unable to print the report", zap.Any("report", report), zap.Error(err))
+	}
+
+	if len(report.Corrupt) > 0 {
+		os.Exit(1)
+	}
+}
+
+func blobReconcileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Copy any blobs that are missing from the primary or its mirrors",
+		Run:   blobReconcile,
+	}
+
+	cmd.Flags().Bool("dry-run", false, "Report which blobs would be copied without copying them")
+
+	return cmd
+}
+
+func blobReconcile(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	logger := zap.L()
+	cfg := GetConfig(ctx)
+	storage := setupStorage(ctx, logger, cfg.Storage)
+	defer storage.Close()
+
+	mirrored, ok := unwrapStorage[*mirrored_storage.MirroredStorage](storage)
+	if !ok {
+		logger.Fatal("Blob mirroring isn't enabled. Did you forget to set --blob-mirror?")
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	report, err := mirrored.Reconcile(ctx, dryRun)
+	if err != nil {
+		logger.Fatal("Reconciliation failed", zap.Error(err))
+	}
+
+	if err := cfg.Format().Print(os.Stdout, report); err != nil {
+		logger.Fatal("Unable to print the report", zap.Any("report", report), zap.Error(err))
+	}
+
+	if len(report.Failed) > 0 {
+		os.Exit(1)
+	}
+}
+
+func blobExpireCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expire",
+		Short: "Delete audio that has outlived its stream's retention period",
+		Long: `Delete audio that has outlived its stream's retention period.
+
+The download command does this periodically, so this is only needed when
+you want to apply a new retention policy straight away.`,
+		Run: blobExpire,
+	}
+
+	return cmd
+}
+
+func blobExpire(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	logger := zap.L()
+	cfg := GetConfig(ctx)
+	db := setupDatabase(ctx, logger, cfg)
+	storage := setupStorage(ctx, logger, cfg.Storage)
+	defer storage.Close()
+
+	report, err := radiochatter.ApplyRetention(ctx, logger.Named("retention"), db, storage)
+	if err != nil {
+		logger.Fatal("Unable to apply the retention settings", zap.Error(err))
+	}
+
+	if err := cfg.Format().Print(os.Stdout, report); err != nil {
+		logger.Fatal("Unable to print the report", zap.Any("report", report), zap.Error(err))
+	}
+}
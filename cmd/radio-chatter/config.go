@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -27,7 +29,7 @@ func configCmd() *cobra.Command {
 		Use:   "config",
 		Short: "Print out the current config",
 		Run: func(cmd *cobra.Command, args []string) {
-			cfg := GetConfig(cmd.Context())
+			cfg := GetConfig(cmd.Context()).Redacted()
 			if err := cfg.Format().Print(os.Stdout, cfg); err != nil {
 				zap.L().Fatal("Unable to print the config", zap.Error(err))
 			}
@@ -38,10 +40,19 @@ func configCmd() *cobra.Command {
 }
 
 type Config struct {
-	Serve    ServeConfig    `mapstructure:"serve" json:"serve"`
-	Storage  StorageConfig  `mapstructure:"storage" json:"storage"`
-	Database DatabaseConfig `mapstructure:"db" json:"db"`
-	Output   OutputConfig   `mapstructure:"out" json:"out"`
+	Serve      ServeConfig      `mapstructure:"serve" json:"serve"`
+	Download   DownloadConfig   `mapstructure:"download" json:"download"`
+	Transcribe TranscribeConfig `mapstructure:"transcribe" json:"transcribe"`
+	Storage    StorageConfig    `mapstructure:"storage" json:"storage"`
+	Database   DatabaseConfig   `mapstructure:"db" json:"db"`
+	Output     OutputConfig     `mapstructure:"out" json:"out"`
+}
+
+// Redacted gets a copy of the config that is safe to print, with any secrets
+// and credentials hidden.
+func (c Config) Redacted() Config {
+	c.Storage = c.Storage.redacted()
+	return c
 }
 
 func (c Config) Format() formatter {
@@ -59,14 +70,89 @@ func (c Config) Format() formatter {
 type ServeConfig struct {
 	Host string `mapstructure:"host" json:"host"`
 	Port uint16 `mapstructure:"port" json:"port"`
+	// How often GraphQL subscriptions check the database for new items.
+	PollInterval time.Duration `mapstructure:"poll-interval" json:"poll-interval"`
 }
 
 func (s ServeConfig) Addr() string {
 	return fmt.Sprintf("%s:%d", s.Host, s.Port)
 }
 
+type DownloadConfig struct {
+	// How long each chunk should be when a stream doesn't override it.
+	ChunkLength time.Duration `mapstructure:"chunk-length" json:"chunk-length"`
+	// How often to check for streams that were added, removed, or changed.
+	WatchInterval time.Duration `mapstructure:"watch-interval" json:"watch-interval"`
+	// How often to delete audio that has outlived its stream's retention
+	// period, or zero to disable it.
+	RetentionInterval time.Duration `mapstructure:"retention-interval" json:"retention-interval"`
+}
+
+type TranscribeConfig struct {
+	// How often to check the database for new transmissions.
+	PollInterval time.Duration `mapstructure:"poll-interval" json:"poll-interval"`
+}
+
 type StorageConfig struct {
 	Blob string `mapstructure:"blob" json:"blob"`
+	// The secret used to sign blob download links. Every process which
+	// generates or serves links must use the same secret.
+	Secret string `mapstructure:"secret" json:"-"`
+	// The address the on-disk blob server listens on, or "none" to disable
+	// it and serve blobs from the serve command's router instead.
+	Listen string `mapstructure:"listen" json:"listen"`
+	// The public URL on-disk blobs are served under.
+	BaseURL string `mapstructure:"base-url" json:"base-url"`
+	// Keys used to encrypt blobs at rest, as a comma-separated list of
+	// "id:base64-key" pairs. The first key is used for new blobs.
+	EncryptionKeys string `mapstructure:"encryption-keys" json:"-"`
+	// Secondary blob storage URLs which every blob is copied to.
+	Mirrors []string `mapstructure:"mirrors" json:"mirrors"`
+	// Whether to wait for blobs to be copied to every mirror ("sync") or copy
+	// them in the background ("async").
+	MirrorMode string `mapstructure:"mirror-mode" json:"mirror-mode"`
+	// The file used to keep track of pending copies in async mode.
+	MirrorJournal string `mapstructure:"mirror-journal" json:"mirror-journal"`
+}
+
+func (s StorageConfig) redacted() StorageConfig {
+	s.Blob = redactURL(s.Blob)
+	mirrors := s.Mirrors
+	s.Mirrors = nil
+	for _, mirror := range mirrors {
+		s.Mirrors = append(s.Mirrors, redactURL(mirror))
+	}
+	if s.Secret != "" {
+		s.Secret = redacted
+	}
+	if s.EncryptionKeys != "" {
+		s.EncryptionKeys = redacted
+	}
+	return s
+}
+
+// redacted is what secrets are replaced with, matching url.URL.Redacted().
+const redacted = "xxxxx"
+
+// redactURL hides the userinfo and query parameters in a URL, because they may
+// contain credentials (e.g. an S3 access key or the blob secret).
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redacted
+	}
+
+	if u.User != nil {
+		u.User = url.User(redacted)
+	}
+
+	query := u.Query()
+	for key := range query {
+		query.Set(key, redacted)
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String()
 }
 
 type DatabaseConfig struct {
@@ -3,6 +3,7 @@ package main
 import (
 	radiochatter "github.com/Michael-F-Bryan/radio-chatter/pkg"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -17,6 +18,18 @@ func downloadCmd() *cobra.Command {
 	registerDatabaseFlags(cmd.Flags())
 	registerStorageFlags(cmd.Flags())
 
+	cmd.Flags().Duration("chunk-length", radiochatter.DefaultChunkLength, "How long each chunk should be, unless the stream overrides it")
+	_ = viper.BindPFlag("download.chunk-length", cmd.Flags().Lookup("chunk-length"))
+	_ = viper.BindEnv("download.chunk-length", "CHUNK_LENGTH")
+
+	cmd.Flags().Duration("watch-interval", radiochatter.DefaultWatchInterval, "How often to check for streams that were added, removed, or changed")
+	_ = viper.BindPFlag("download.watch-interval", cmd.Flags().Lookup("watch-interval"))
+	_ = viper.BindEnv("download.watch-interval", "WATCH_INTERVAL")
+
+	cmd.Flags().Duration("retention-interval", radiochatter.DefaultRetentionInterval, "How often to delete audio that has outlived its stream's retention period (0 to disable)")
+	_ = viper.BindPFlag("download.retention-interval", cmd.Flags().Lookup("retention-interval"))
+	_ = viper.BindEnv("download.retention-interval", "RETENTION_INTERVAL")
+
 	return cmd
 }
 
@@ -26,18 +39,28 @@ func download(cmd *cobra.Command, args []string) {
 	cfg := GetConfig(ctx)
 
 	group, ctx := errgroup.WithContext(ctx)
-	storage := setupStorage(logger, cfg.Storage)
+	storage := setupStorage(ctx, logger, cfg.Storage)
 	defer storage.Close()
 	db := setupDatabase(ctx, logger, cfg)
 
-	var streams []radiochatter.Stream
-	if err := db.Find(&streams).Error; err != nil {
-		logger.Fatal("Unable to load the streams", zap.Error(err))
+	downloader := radiochatter.NewDownloader(logger, db, storage)
+	downloader.Defaults.ChunkLength = cfg.Download.ChunkLength
+	if err := downloader.Defaults.Validate(); err != nil {
+		logger.Fatal("Invalid chunk length", zap.Error(err))
 	}
 
-	for _, stream := range streams {
-		cleanup := radiochatter.StartProcessing(ctx, logger, group, stream, storage, db)
-		defer cleanup()
+	if cfg.Download.WatchInterval <= 0 {
+		logger.Fatal("The watch interval must be positive", zap.Duration("watch-interval", cfg.Download.WatchInterval))
+	}
+
+	group.Go(func() error {
+		return downloader.Watch(ctx, cfg.Download.WatchInterval)
+	})
+
+	if interval := cfg.Download.RetentionInterval; interval > 0 {
+		group.Go(func() error {
+			return radiochatter.RunRetention(ctx, logger.Named("retention"), db, storage, interval)
+		})
 	}
 
 	defer logger.Info("Exit")
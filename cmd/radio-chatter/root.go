@@ -23,7 +23,7 @@ func rootCmd() *cobra.Command {
 		PersistentPostRun: afterAll,
 	}
 
-	cmd.AddCommand(downloadCmd(), streamCmd(), serveCmd(), configCmd(), transcribeCmd())
+	cmd.AddCommand(downloadCmd(), streamCmd(), serveCmd(), configCmd(), transcribeCmd(), blobCmd())
 
 	flags := cmd.PersistentFlags()
 	flags.BoolP("dev", "d", false, "Run the application in dev mode")
@@ -57,7 +57,7 @@ func beforeAll(cmd *cobra.Command, args []string) {
 
 	initializeLogger(cfg.Output)
 
-	zap.L().Debug("Loaded config", zap.Any("settings", cfg))
+	zap.L().Debug("Loaded config", zap.Any("settings", cfg.Redacted()))
 
 	post := cmd.PersistentPostRun
 	cmd.PersistentPostRun = func(cmd *cobra.Command, args []string) {
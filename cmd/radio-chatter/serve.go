@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	radiochatter "github.com/Michael-F-Bryan/radio-chatter/pkg"
 	"github.com/Michael-F-Bryan/radio-chatter/pkg/handlers"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -32,6 +33,10 @@ func serveCmd() *cobra.Command {
 	_ = viper.BindPFlag("serve.port", cmd.Flags().Lookup("port"))
 	_ = viper.BindEnv("serve.port", "PORT")
 
+	cmd.Flags().Duration("poll-interval", radiochatter.DefaultPollInterval, "How often GraphQL subscriptions check for new items")
+	_ = viper.BindPFlag("serve.poll-interval", cmd.Flags().Lookup("poll-interval"))
+	_ = viper.BindEnv("serve.poll-interval", "POLL_INTERVAL")
+
 	return cmd
 }
 
@@ -40,14 +45,14 @@ func serve(cmd *cobra.Command, args []string) {
 	ctx := cmd.Context()
 	cfg := GetConfig(ctx)
 
-	storage := setupStorage(logger, cfg.Storage)
+	storage := setupStorage(ctx, logger, cfg.Storage)
 	defer storage.Close()
 	db := setupDatabase(ctx, logger, cfg)
 	addr := cfg.Serve.Addr()
 
 	server := http.Server{
 		Addr:    addr,
-		Handler: handlers.Router(logger, db, storage, cfg.Output.DevMode),
+		Handler: handlers.Router(logger, db, storage, cfg.Serve.PollInterval, cfg.Output.DevMode),
 	}
 
 	go func() {
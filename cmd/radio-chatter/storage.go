@@ -1,41 +1,193 @@
 package main
 
 import (
+	"context"
+	"net/url"
 	"os"
 	"path"
+	"path/filepath"
 
+	radiochatter "github.com/Michael-F-Bryan/radio-chatter/pkg"
 	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
-	"github.com/Michael-F-Bryan/radio-chatter/pkg/on_disk_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/encrypted_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mirrored_storage"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
 
 func registerStorageFlags(flags *pflag.FlagSet) {
-	flags.StringP("blob", "b", "", "Blob storage (user's cache dir by default)")
-	_ = viper.BindPFlag("blob", flags.Lookup("blob"))
+	flags.StringP("blob", "b", "", "Where to store blobs (e.g. file:///var/blobs, s3://bucket/prefix, or mem://). Defaults to the user's cache dir")
+	_ = viper.BindPFlag("storage.blob", flags.Lookup("blob"))
 	_ = viper.BindEnv("storage.blob", "BLOB_URL")
+
+	flags.String("blob-secret", "", "The secret used to sign blob download links")
+	_ = viper.BindPFlag("storage.secret", flags.Lookup("blob-secret"))
+	_ = viper.BindEnv("storage.secret", "BLOB_SECRET")
+
+	flags.String("blob-listen", "", `The address the on-disk blob server listens on, or "none" to serve blobs from the API server under /blobs/`)
+	_ = viper.BindPFlag("storage.listen", flags.Lookup("blob-listen"))
+	_ = viper.BindEnv("storage.listen", "BLOB_LISTEN")
+
+	flags.String("blob-base-url", "", "The public URL on-disk blobs are served under (e.g. https://audio.example/blobs)")
+	_ = viper.BindPFlag("storage.base-url", flags.Lookup("blob-base-url"))
+	_ = viper.BindEnv("storage.base-url", "BLOB_BASE_URL")
+
+	flags.String("blob-encryption-keys", "", `Encrypt blobs at rest using these keys (a comma-separated list of "id:base64-key" pairs, where the first key is used for new blobs)`)
+	_ = viper.BindPFlag("storage.encryption-keys", flags.Lookup("blob-encryption-keys"))
+	_ = viper.BindEnv("storage.encryption-keys", "BLOB_ENCRYPTION_KEYS")
+
+	flags.StringSlice("blob-mirror", nil, "Copy every blob to this secondary blob storage URL (can be repeated)")
+	_ = viper.BindPFlag("storage.mirrors", flags.Lookup("blob-mirror"))
+	_ = viper.BindEnv("storage.mirrors", "BLOB_MIRRORS")
+
+	flags.String("blob-mirror-mode", string(mirrored_storage.ModeSync), `Wait for blobs to be copied to every mirror ("sync") or copy them in the background ("async")`)
+	_ = viper.BindPFlag("storage.mirror-mode", flags.Lookup("blob-mirror-mode"))
+	_ = viper.BindEnv("storage.mirror-mode", "BLOB_MIRROR_MODE")
+
+	flags.String("blob-mirror-journal", "", "A file used to keep track of copies that haven't been made yet in async mode")
+	_ = viper.BindPFlag("storage.mirror-journal", flags.Lookup("blob-mirror-journal"))
+	_ = viper.BindEnv("storage.mirror-journal", "BLOB_MIRROR_JOURNAL")
 }
 
-func setupStorage(logger *zap.Logger, cfg StorageConfig) blob.Storage {
+func setupStorage(ctx context.Context, logger *zap.Logger, cfg StorageConfig) blob.Storage {
 	if logger.Name() != "storage" {
 		logger = logger.Named("storage")
 	}
 
-	baseDir := cfg.Blob
+	rawURL := cfg.Blob
 
-	if baseDir == "" {
+	if rawURL == "" {
 		cacheDir, err := os.UserCacheDir()
 		if err != nil {
 			logger.Fatal("Unable to get the user's cache directory", zap.Error(err))
 		}
-		baseDir = path.Join(cacheDir, "radio-chatter", "blob-storage")
+		u := url.URL{Scheme: "file", Path: path.Join(cacheDir, "radio-chatter", "blob-storage")}
+		rawURL = u.String()
 	}
 
-	storage, err := on_disk_storage.New(logger, baseDir)
+	rawURL = withStorageOptions(logger, rawURL, cfg)
+
+	storage, err := radiochatter.OpenStorage(ctx, logger, rawURL)
 	if err != nil {
-		logger.Fatal("Unable to set up the on-disk storage", zap.Error(err))
+		logger.Fatal("Unable to set up blob storage", zap.Error(err))
+	}
+
+	if len(cfg.Mirrors) > 0 {
+		storage = setupMirrors(ctx, logger, storage, cfg)
+	}
+
+	if cfg.EncryptionKeys != "" {
+		keyring, err := encrypted_storage.ParseKeyring(cfg.EncryptionKeys)
+		if err != nil {
+			logger.Fatal("Unable to parse the blob encryption keys", zap.Error(err))
+		}
+
+		encrypted, err := encrypted_storage.New(logger.Named("encrypted"), storage, encrypted_storage.Config{
+			Keyring: keyring,
+			Secret:  []byte(cfg.Secret),
+			BaseURL: cfg.BaseURL,
+		})
+		if err != nil {
+			logger.Fatal("Unable to enable blob encryption", zap.Error(err))
+		}
+
+		logger.Info("Blobs will be encrypted at rest", zap.String("key-id", keyring.Current()))
+		storage = encrypted
 	}
 
 	return storage
 }
+
+// setupMirrors wraps the primary blob storage so every blob is also copied to
+// the configured mirrors.
+func setupMirrors(ctx context.Context, logger *zap.Logger, primary blob.Storage, cfg StorageConfig) blob.Storage {
+	replicas := []blob.Storage{primary}
+
+	// Note: Only the primary should use the listen address and base URL
+	mirrorCfg := StorageConfig{Secret: cfg.Secret}
+
+	for i, rawURL := range cfg.Mirrors {
+		rawURL = withStorageOptions(logger, rawURL, mirrorCfg)
+
+		replica, err := radiochatter.OpenStorage(ctx, logger.Named("mirror"), rawURL)
+		if err != nil {
+			logger.Fatal("Unable to set up a blob storage mirror", zap.Int("mirror", i+1), zap.Error(err))
+		}
+
+		replicas = append(replicas, replica)
+	}
+
+	mirrored, err := mirrored_storage.New(logger.Named("mirrored"), replicas, mirrored_storage.Config{
+		Mode:        mirrored_storage.Mode(cfg.MirrorMode),
+		JournalFile: cfg.MirrorJournal,
+	})
+	if err != nil {
+		logger.Fatal("Unable to set up blob mirroring", zap.Error(err))
+	}
+
+	logger.Info(
+		"Mirroring blobs",
+		zap.Int("mirrors", len(cfg.Mirrors)),
+		zap.String("mode", cfg.MirrorMode),
+	)
+
+	return mirrored
+}
+
+// unwrapStorage finds a particular type of blob.Storage, looking through any
+// wrappers (e.g. encryption) that have been applied to it.
+func unwrapStorage[T blob.Storage](storage blob.Storage) (T, bool) {
+	for {
+		if s, ok := storage.(T); ok {
+			return s, true
+		}
+
+		wrapper, ok := storage.(interface{ Unwrap() blob.Storage })
+		if !ok {
+			var zero T
+			return zero, false
+		}
+		storage = wrapper.Unwrap()
+	}
+}
+
+// withStorageOptions passes the settings from the command-line to on-disk
+// storage via query parameters, unless the URL already specifies them.
+func withStorageOptions(logger *zap.Logger, rawURL string, cfg StorageConfig) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme == "" {
+		// Looks like a plain path. Note that it needs to be absolute,
+		// otherwise the file URL would be parsed as an opaque one.
+		path, err := filepath.Abs(rawURL)
+		if err != nil {
+			// Let radiochatter.OpenStorage() deal with it
+			return rawURL
+		}
+		u = &url.URL{Scheme: "file", Path: path}
+	}
+
+	if u.Scheme != "file" {
+		// Other backends don't understand these options
+		return rawURL
+	}
+
+	query := u.Query()
+
+	if cfg.Secret == "" && !query.Has("secret") {
+		logger.Warn("No blob secret was provided, so download links will only work while this process is running")
+	}
+
+	for key, value := range map[string]string{
+		"secret":   cfg.Secret,
+		"listen":   cfg.Listen,
+		"base-url": cfg.BaseURL,
+	} {
+		if value != "" && !query.Has(key) {
+			query.Set(key, value)
+		}
+	}
+
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -5,6 +5,7 @@ import (
 
 	radiochatter "github.com/Michael-F-Bryan/radio-chatter/pkg"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"go.uber.org/zap"
 )
 
@@ -16,7 +17,7 @@ func streamCmd() *cobra.Command {
 
 	registerDatabaseFlags(cmd.PersistentFlags())
 
-	cmd.AddCommand(streamListCmd(), streamAddCmd(), streamRemoveCmd())
+	cmd.AddCommand(streamListCmd(), streamAddCmd(), streamRemoveCmd(), streamRetentionCmd(), streamStatusCmd(), streamHealthCmd())
 
 	return cmd
 }
@@ -56,9 +57,29 @@ func streamAddCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(2),
 	}
 
+	registerRetentionFlags(cmd.Flags())
+
+	flags := cmd.Flags()
+	flags.Duration("chunk-length", 0, "How long each chunk should be (0 to use the download command's default)")
+	flags.String("chunk-codec", string(radiochatter.CodecMP3), "The format chunks are saved in (mp3, opus, wav)")
+	flags.String("transmission-codec", "", "The format transmissions are saved in (mp3, opus, wav), if it differs from the chunks")
+	flags.String("audio-filter", string(radiochatter.AudioFilterNone), "How to clean up the audio before looking for transmissions (none, light, voice, noisy)")
+	flags.Bool("keep-raw-chunks", false, "Keep each chunk's raw audio alongside the cleaned up version")
+	flags.String("silence-detector", string(radiochatter.DetectSilenceFFmpeg), "How to detect silence (silencedetect, vad)")
+	flags.Float64("noise-threshold", radiochatter.DefaultNoiseThreshold, "Audio quieter than this (in dB) is treated as silence")
+	flags.Duration("min-silence", radiochatter.DefaultMinSilence, "How long the audio needs to be quiet before it counts as silence")
+	flags.Duration("padding", radiochatter.DefaultPadding, "How much audio to keep either side of a transmission")
+	flags.String("restart-policy", string(radiochatter.RestartAlways), "When to restart the stream after it stops (always, on-failure)")
+	flags.Int("max-failures", 0, "Stop recording the stream after this many consecutive failures (0 to never give up)")
+
 	return cmd
 }
 
+func registerRetentionFlags(flags *pflag.FlagSet) {
+	flags.Duration("chunk-retention", 0, "How long to keep the audio for raw chunks (0 to keep it forever)")
+	flags.Duration("transmission-retention", 0, "How long to keep the audio for transmissions (0 to keep it forever)")
+}
+
 func streamAdd(cmd *cobra.Command, args []string) {
 	ctx := cmd.Context()
 	logger := zap.L()
@@ -69,6 +90,69 @@ func streamAdd(cmd *cobra.Command, args []string) {
 		DisplayName: args[0],
 		Url:         args[1],
 	}
+	stream.ChunkRetention, _ = cmd.Flags().GetDuration("chunk-retention")
+	stream.TransmissionRetention, _ = cmd.Flags().GetDuration("transmission-retention")
+
+	// Note: Only save the settings that were set explicitly so the stream
+	// picks up any changes to the defaults.
+	flags := cmd.Flags()
+	stream.ChunkLength, _ = flags.GetDuration("chunk-length")
+	for _, codec := range []struct {
+		flag string
+		dest *radiochatter.AudioCodec
+	}{
+		{"chunk-codec", &stream.ChunkCodec},
+		{"transmission-codec", &stream.TransmissionCodec},
+	} {
+		raw, _ := flags.GetString(codec.flag)
+		if !flags.Changed(codec.flag) || raw == "" {
+			continue
+		}
+		parsed, err := radiochatter.ParseAudioCodec(raw)
+		if err != nil {
+			logger.Fatal("Invalid audio codec", zap.String("flag", codec.flag), zap.Error(err))
+		}
+		*codec.dest = parsed
+	}
+	if flags.Changed("audio-filter") {
+		rawFilter, _ := flags.GetString("audio-filter")
+		filter, err := radiochatter.ParseAudioFilter(rawFilter)
+		if err != nil {
+			logger.Fatal("Invalid audio filter", zap.Error(err))
+		}
+		stream.AudioFilter = filter
+	}
+	stream.KeepRawChunks, _ = flags.GetBool("keep-raw-chunks")
+	if flags.Changed("silence-detector") {
+		rawDetector, _ := flags.GetString("silence-detector")
+		detector, err := radiochatter.ParseSilenceDetector(rawDetector)
+		if err != nil {
+			logger.Fatal("Invalid silence detector", zap.Error(err))
+		}
+		stream.SilenceDetector = detector
+	}
+	if flags.Changed("noise-threshold") {
+		stream.NoiseThreshold, _ = flags.GetFloat64("noise-threshold")
+	}
+	if flags.Changed("min-silence") {
+		stream.MinSilence, _ = flags.GetDuration("min-silence")
+	}
+	if flags.Changed("padding") {
+		padding, _ := flags.GetDuration("padding")
+		stream.Padding = &padding
+	}
+
+	rawPolicy, _ := flags.GetString("restart-policy")
+	policy, err := radiochatter.ParseRestartPolicy(rawPolicy)
+	if err != nil {
+		logger.Fatal("Invalid restart policy", zap.Error(err))
+	}
+	stream.RestartPolicy = policy
+	stream.MaxFailures, _ = flags.GetInt("max-failures")
+
+	if err := stream.PreprocessOptions().Validate(); err != nil {
+		logger.Fatal("Invalid preprocessing settings", zap.Error(err))
+	}
 
 	if err := db.Save(&stream).Error; err != nil {
 		logger.Fatal(
@@ -121,3 +205,110 @@ func streamRemove(cmd *cobra.Command, args []string) {
 		)
 	}
 }
+
+func streamRetentionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retention",
+		Short: "Change how long a stream's audio is kept for",
+		Run:   streamRetention,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	registerRetentionFlags(cmd.Flags())
+
+	return cmd
+}
+
+func streamRetention(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	logger := zap.L()
+	cfg := GetConfig(ctx)
+	db := setupDatabase(ctx, logger, cfg)
+
+	var stream radiochatter.Stream
+	if err := db.Where("display_name = ?", args[0]).First(&stream).Error; err != nil {
+		logger.Fatal(
+			"Unable to find the stream",
+			zap.String("name", args[0]),
+			zap.Error(err),
+		)
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("chunk-retention") {
+		stream.ChunkRetention, _ = flags.GetDuration("chunk-retention")
+	}
+	if flags.Changed("transmission-retention") {
+		stream.TransmissionRetention, _ = flags.GetDuration("transmission-retention")
+	}
+
+	if err := db.Save(&stream).Error; err != nil {
+		logger.Fatal(
+			"Unable to save the stream",
+			zap.Any("stream", stream),
+			zap.Error(err),
+		)
+	}
+
+	if err := cfg.Format().Print(os.Stdout, &stream); err != nil {
+		logger.Fatal(
+			"Unable to print the stream",
+			zap.Any("stream", stream),
+			zap.Error(err),
+		)
+	}
+}
+
+func streamStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show what the downloader is doing with each stream",
+		Run:   streamStatus,
+	}
+
+	return cmd
+}
+
+func streamStatus(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	logger := zap.L()
+	cfg := GetConfig(ctx)
+	db := setupDatabase(ctx, logger, cfg)
+
+	var statuses []radiochatter.StreamStatus
+
+	if err := db.Order("stream_id").Find(&statuses).Error; err != nil {
+		logger.Fatal("Unable to load the stream statuses", zap.Error(err))
+	}
+
+	if err := cfg.Format().Print(os.Stdout, statuses); err != nil {
+		logger.Fatal("Unable to print the stream statuses", zap.Error(err))
+	}
+}
+
+func streamHealthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Show the latest heartbeat the downloader sent for each stream",
+		Run:   streamHealth,
+	}
+
+	return cmd
+}
+
+func streamHealth(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	logger := zap.L()
+	cfg := GetConfig(ctx)
+	db := setupDatabase(ctx, logger, cfg)
+
+	var health []radiochatter.StreamHealth
+
+	if err := db.Order("stream_id").Find(&health).Error; err != nil {
+		logger.Fatal("Unable to load the stream health", zap.Error(err))
+	}
+
+	if err := cfg.Format().Print(os.Stdout, health); err != nil {
+		logger.Fatal("Unable to print the stream health", zap.Error(err))
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	radiochatter "github.com/Michael-F-Bryan/radio-chatter/pkg"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
 
@@ -14,6 +15,11 @@ func transcribeCmd() *cobra.Command {
 	}
 	registerDatabaseFlags(cmd.Flags())
 	registerStorageFlags(cmd.Flags())
+
+	cmd.Flags().Duration("poll-interval", radiochatter.DefaultPollInterval, "How often to check for new transmissions")
+	_ = viper.BindPFlag("transcribe.poll-interval", cmd.Flags().Lookup("poll-interval"))
+	_ = viper.BindEnv("transcribe.poll-interval", "TRANSCRIBE_POLL_INTERVAL")
+
 	return cmd
 }
 
@@ -22,13 +28,13 @@ func transcribe(cmd *cobra.Command, args []string) {
 	logger := zap.L()
 	cfg := GetConfig(ctx)
 	db := setupDatabase(ctx, logger, cfg)
-	storage := setupStorage(logger, cfg.Storage)
+	storage := setupStorage(ctx, logger, cfg.Storage)
 	defer storage.Close()
 	whisper := radiochatter.NewWhisperTranscriber(logger.Named("whisper"))
 
 	logger.Info("Started running speech-to-text")
 
-	if err := radiochatter.Transcribe(ctx, logger.Named("transcribe"), db, whisper, storage); err != nil {
+	if err := radiochatter.Transcribe(ctx, logger.Named("transcribe"), db, whisper, storage, cfg.Transcribe.PollInterval); err != nil {
 		logger.Fatal("Transcription failed", zap.Error(err))
 	}
 }
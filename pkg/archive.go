@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"math/rand"
 	"os"
 	"os/exec"
@@ -23,24 +24,33 @@ type ArchiveState struct {
 	Storage blob.Storage
 	DB      *gorm.DB
 	Stream  Stream
+	// The stream's settings, including any deployment-wide defaults. These
+	// must match the options passed to Preprocess.
+	Options PreprocessOptions
 }
 
 // ArchiveCallbacks gets a set of PreprocessingCallbacks that will send archiver
 // operations down a channel in response to preprocessing events.
-func ArchiveCallbacks(ctx context.Context, ch chan<- ArchiveOperation) PreprocessingCallbacks {
-	return archiveCallbacks(ctx, ch, time.Now)
+//
+// The options must match the ones passed to Preprocess so timestamps can be
+// calculated correctly and any raw chunks can be found.
+func ArchiveCallbacks(ctx context.Context, ch chan<- ArchiveOperation, opts PreprocessOptions) PreprocessingCallbacks {
+	return archiveCallbacks(ctx, ch, opts, time.Now)
 }
 
-func archiveCallbacks(ctx context.Context, ch chan<- ArchiveOperation, now func() time.Time) PreprocessingCallbacks {
+func archiveCallbacks(ctx context.Context, ch chan<- ArchiveOperation, opts PreprocessOptions, now func() time.Time) PreprocessingCallbacks {
 	a := archiver{
-		ctx: ctx,
-		ch:  ch,
-		now: now,
+		ctx:         ctx,
+		ch:          ch,
+		now:         now,
+		chunkLength: opts.ChunkLength,
+		rawChunks:   opts.writesRawChunks(),
 	}
 
 	cb := PreprocessingCallbacks{
 		DownloadStarted: a.onDownloadStarted,
 		StartWriting:    a.onStartWriting,
+		SegmentComplete: a.onSegmentComplete,
 		SilenceStart:    a.onSilenceStart,
 		SilenceEnd:      a.onSilenceEnd,
 		Finished:        a.onFinished,
@@ -50,16 +60,28 @@ func archiveCallbacks(ctx context.Context, ch chan<- ArchiveOperation, now func(
 }
 
 type archiver struct {
-	ch  chan<- ArchiveOperation
-	ctx context.Context
-	now func() time.Time
-
-	currentFile      string
+	ch          chan<- ArchiveOperation
+	ctx         context.Context
+	now         func() time.Time
+	chunkLength time.Duration
+	// Is ffmpeg writing an untouched copy of each chunk?
+	rawChunks bool
+
+	currentFile string
+	// The untouched copy of the current file, if there is one.
+	currentRawFile string
+	filesStarted   int
+	// Where the current file starts, relative to the start of the input.
+	currentStart time.Duration
+	// The current file's entry in the segment list, once ffmpeg has
+	// finished writing it.
+	segment          *Segment
 	recordingStarted time.Time
-	fileIndex        int
 	inSilence        bool
 	audioStarted     time.Duration
-	spans            []audioSpan
+	// Audio detected in the current file, relative to the start of the
+	// input.
+	spans []audioSpan
 }
 
 func (a *archiver) onDownloadStarted() {
@@ -68,10 +90,18 @@ func (a *archiver) onDownloadStarted() {
 
 func (a *archiver) onStartWriting(path string) {
 	if a.currentFile != "" {
-		a.completeFile(true)
-		a.fileIndex++
+		a.currentStart = a.completeFile(true)
 	}
 	a.currentFile = path
+	a.currentRawFile = ""
+	if a.rawChunks {
+		a.currentRawFile = rawChunkPath(path, a.filesStarted)
+	}
+	a.filesStarted++
+}
+
+func (a *archiver) onSegmentComplete(seg Segment) {
+	a.segment = &seg
 }
 
 func (a *archiver) onFinished() {
@@ -83,10 +113,9 @@ func (a *archiver) onFinished() {
 }
 
 func (a *archiver) onSilenceStart(t time.Duration) {
-	startOffset := ChunkLength * time.Duration(a.fileIndex)
 	span := audioSpan{
-		Start: a.audioStarted - startOffset,
-		End:   t - startOffset,
+		Start: a.audioStarted,
+		End:   t,
 	}
 
 	// Note: We want to ignore tiny spans of audio
@@ -102,62 +131,107 @@ func (a *archiver) onSilenceEnd(t time.Duration, duration time.Duration) {
 	a.inSilence = false
 }
 
-func (a *archiver) completeFile(audioMayContinue bool) {
-	startOffset := ChunkLength * time.Duration(a.fileIndex)
-	clipStart := a.recordingStarted.Add(startOffset).UTC()
+// completeFile sends the current file off to be archived, returning where it
+// ends relative to the start of the input.
+func (a *archiver) completeFile(audioMayContinue bool) time.Duration {
+	// Note: The segment list tells us exactly where each chunk starts and
+	// ends, but we need to fall back to the nominal chunk length if ffmpeg
+	// didn't write one.
+	start := a.currentStart
+	end := start + a.chunkLength
+	if a.segment != nil && filepath.Base(a.segment.Path) == filepath.Base(a.currentFile) {
+		start = a.segment.Start
+		end = a.segment.End
+	}
+	a.segment = nil
 
 	op := ArchiveOperation{
 		Path:      a.currentFile,
-		Timestamp: clipStart,
+		RawPath:   a.currentRawFile,
+		Timestamp: a.chunkTimestamp(start),
+		Length:    end - start,
 	}
 
 	if !a.inSilence && audioMayContinue {
 		// Make sure we handle audio that continues across the end of the
 		// current clip
-		endOfChunk := startOffset + ChunkLength
-		span := audioSpan{
-			Start: a.audioStarted - startOffset,
-			End:   endOfChunk - startOffset,
-		}
-		a.spans = append(a.spans, span)
+		a.spans = append(a.spans, audioSpan{Start: a.audioStarted, End: end})
 		// Make sure the next audio clip doesn't include the bits we got
-		a.audioStarted = endOfChunk
+		a.audioStarted = end
 	}
 
-	if a.spans != nil {
-		op.Pieces = a.spans
-		a.spans = nil
+	for _, span := range a.spans {
+		op.Pieces = append(op.Pieces, audioSpan{Start: span.Start - start, End: span.End - start})
 	}
+	a.spans = nil
 
 	select {
 	case a.ch <- op:
 	case <-a.ctx.Done():
 	}
+
+	return end
+}
+
+// chunkTimestamp works out the wall-clock time a chunk started.
+//
+// Chunks from live streams are named after the time ffmpeg started writing
+// them. That name is only accurate to the second, so we prefer to use the
+// chunk's offset from when recording started, unless it has drifted away from
+// the name (e.g. because the stream was buffering).
+func (a *archiver) chunkTimestamp(start time.Duration) time.Time {
+	timestamp := a.recordingStarted.Add(start)
+
+	if named, ok := parseChunkTimestamp(a.currentFile); ok {
+		if drift := timestamp.Sub(named); drift < 0 || drift >= time.Second {
+			// Start counting from the chunk's name again so later
+			// chunks don't drift either
+			a.recordingStarted = named.Add(-start)
+			timestamp = named
+		}
+	}
+
+	return timestamp.UTC()
 }
 
 type ArchiveOperation struct {
 	Path string
+	// The chunk before the stream's audio filter was applied, if it is being
+	// kept.
+	RawPath string
 	// When the chunk started.
 	Timestamp time.Time
-	Pieces    []audioSpan
+	// How long the chunk is.
+	Length time.Duration
+	Pieces []audioSpan
 }
 
 func (a ArchiveOperation) Execute(ctx context.Context, state ArchiveState) error {
-	data, err := os.ReadFile(a.Path)
-	if err != nil {
-		return fmt.Errorf("unable to read %q: %w", a.Path, err)
+	opts := state.Options
+	chunk := Chunk{
+		TimeStamp: a.Timestamp,
+		Length:    a.Length,
+		Codec:     opts.ChunkCodec,
+		MediaType: opts.ChunkCodec.MediaType(),
+		StreamID:  state.Stream.ID,
 	}
 
-	key, err := state.Storage.Store(ctx, data)
-	if err != nil {
-		return fmt.Errorf("unable to save %q to blob storage: %w", a.Path, err)
+	if a.RawPath != "" {
+		rawKey, err := storeRawChunk(ctx, state, a.RawPath)
+		if err != nil {
+			return err
+		}
+		chunk.RawSha256 = rawKey
 	}
 
-	chunk := Chunk{
-		TimeStamp: a.Timestamp,
-		Sha256:    key.String(),
-		StreamID:  state.Stream.ID,
+	// Note: ffmpeg has already applied the stream's audio filter, so the
+	// chunk can be saved as-is
+	key, size, err := storeFile(ctx, state.Storage, a.Path)
+	if err != nil {
+		return err
 	}
+
+	chunk.Sha256 = key.String()
 	if err := state.DB.Save(&chunk).Error; err != nil {
 		return fmt.Errorf("unable to save the chunk for %q (%s): %w", a.Path, key, err)
 	}
@@ -165,12 +239,12 @@ func (a ArchiveOperation) Execute(ctx context.Context, state ArchiveState) error
 	state.Logger.Info(
 		"Saved chunk",
 		zap.String("path", a.Path),
-		zap.Int("bytes", len(data)),
+		zap.Int64("bytes", size),
 		zap.Any("chunk", chunk),
 	)
 
-	if chunk.Transmissions != nil {
-		if err := splitChunk(ctx, state, a, chunk); err != nil {
+	if a.Pieces != nil {
+		if err := splitChunk(ctx, state, a.Path, a.Pieces, chunk); err != nil {
 			return err
 		}
 	}
@@ -183,17 +257,17 @@ func (a ArchiveOperation) Execute(ctx context.Context, state ArchiveState) error
 	return nil
 }
 
-func splitChunk(ctx context.Context, state ArchiveState, a ArchiveOperation, chunk Chunk) error {
+func splitChunk(ctx context.Context, state ArchiveState, path string, pieces []audioSpan, chunk Chunk) error {
 	state.Logger.Debug(
 		"Splitting",
-		zap.String("path", a.Path),
-		zap.Any("snippets", a.Pieces),
+		zap.String("path", path),
+		zap.Any("snippets", pieces),
 	)
 
 	group, ctx := errgroup.WithContext(ctx)
 
-	for _, piece := range a.Pieces {
-		group.Go(splitAudioJob(ctx, state, a.Path, piece, chunk))
+	for _, piece := range pieces {
+		group.Go(splitAudioJob(ctx, state, path, piece, chunk))
 	}
 
 	if err := group.Wait(); err != nil {
@@ -211,18 +285,12 @@ func splitAudioJob(ctx context.Context, state ArchiveState, path string, span au
 }
 
 func splitAudio(ctx context.Context, state ArchiveState, path string, span audioSpan, chunk Chunk) (Transmission, error) {
-	tmp := filepath.Join(os.TempDir(), fmt.Sprintf("split-%d.mp3", rand.Int63()))
-	defer func() {
-		if err := os.Remove(tmp); err != nil {
-			state.Logger.Warn(
-				"Unable to delete the temporary file",
-				zap.String("path", tmp),
-				zap.Error(err),
-			)
-		}
-	}()
+	opts := state.Options
+	codec := opts.TransmissionCodec
+	tmp := filepath.Join(os.TempDir(), fmt.Sprintf("split-%d.%s", rand.Int63(), codec.Extension()))
+	defer removeTempFile(state.Logger, tmp)
 
-	buffer := 100 * time.Millisecond
+	buffer := opts.Padding
 	segmentStart := span.Start
 	duration := span.Duration()
 
@@ -243,14 +311,75 @@ func splitAudio(ctx context.Context, state ArchiveState, path string, span audio
 		"-ss", fmt.Sprint(segmentStart.Seconds()),
 		// Time duration
 		"-t", fmt.Sprint(duration.Seconds()),
+	}
+	if codec == opts.ChunkCodec {
 		// Reuse the same codec
-		"-acodec", "copy",
+		args = append(args, "-acodec", "copy")
+	} else {
+		args = append(args, codec.encoderArgs()...)
+	}
+	args = append(args,
 		// Clean up the output so it's easier to troubleshoot
 		"-hide_banner", "-nostdin", "-nostats",
 		// We want to write output to our temporary file
 		tmp,
+	)
+
+	if err := runFFmpeg(ctx, state.Logger, args); errors.Is(err, context.Canceled) {
+		return Transmission{}, err
+	} else if err != nil {
+		return Transmission{}, fmt.Errorf("unable to extract %s from %q: %w", span, path, err)
+	}
+
+	key, size, err := storeFile(ctx, state.Storage, tmp)
+	if err != nil {
+		return Transmission{}, fmt.Errorf("unable to store %s from %q: %w", span, path, err)
 	}
 
+	transmission := Transmission{
+		TimeStamp: chunk.TimeStamp.Add(span.Start),
+		Length:    span.Duration(),
+		Codec:     codec,
+		MediaType: codec.MediaType(),
+		Sha256:    key.String(),
+		ChunkID:   chunk.ID,
+	}
+
+	if err := state.DB.Save(&transmission).Error; err != nil {
+		return Transmission{}, fmt.Errorf("unable to save transmission: %w", err)
+	}
+
+	state.Logger.Info(
+		"Saved transmission",
+		zap.Any("transmission", transmission),
+		zap.Int64("bytes", size),
+	)
+
+	return transmission, nil
+}
+
+// storeRawChunk saves the untouched copy of a chunk, returning its key.
+//
+// Note: The two copies of the audio are split into chunks separately, so the
+// raw copy of the last chunk may not have been written.
+func storeRawChunk(ctx context.Context, state ArchiveState, path string) (string, error) {
+	key, _, err := storeFile(ctx, state.Storage, path)
+	if errors.Is(err, fs.ErrNotExist) {
+		state.Logger.Warn("The raw chunk is missing", zap.String("path", path))
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	if err := os.Remove(path); err != nil {
+		return "", fmt.Errorf("unable to delete %q: %w", path, err)
+	}
+
+	return key.String(), nil
+}
+
+// runFFmpeg runs ffmpeg to completion, logging its output if it fails.
+func runFFmpeg(ctx context.Context, logger *zap.Logger, args []string) error {
 	cmd := exec.CommandContext(ctx, ffmpegCommand, args...)
 
 	stdout := &bytes.Buffer{}
@@ -258,17 +387,17 @@ func splitAudio(ctx context.Context, state ArchiveState, path string, span audio
 	stderr := &bytes.Buffer{}
 	cmd.Stderr = stderr
 
-	state.Logger.Debug("splitting with ffmpeg", zap.Stringer("cmd", cmd))
+	logger.Debug("Running ffmpeg", zap.Stringer("cmd", cmd))
 
 	err := cmd.Run()
 
 	if commandWasCancelled(ctx, err) {
-		return Transmission{}, context.Canceled
+		return context.Canceled
 	} else if err != nil {
 		var exitError *exec.ExitError
 
 		if errors.As(err, &exitError) {
-			state.Logger.Warn(
+			logger.Warn(
 				"ffmpeg errored out",
 				zap.Stringer("cmd", cmd),
 				zap.Int("code", exitError.ExitCode()),
@@ -277,37 +406,41 @@ func splitAudio(ctx context.Context, state ArchiveState, path string, span audio
 			)
 		}
 
-		return Transmission{}, fmt.Errorf("unable to extract %s from %q: %w", span, path, err)
+		return err
 	}
 
-	buf, err := os.ReadFile(tmp)
-	if err != nil {
-		return Transmission{}, fmt.Errorf("unable to read the split from %q: %w", tmp, err)
+	return nil
+}
+
+func removeTempFile(logger *zap.Logger, path string) {
+	if err := os.Remove(path); err != nil {
+		logger.Warn(
+			"Unable to delete the temporary file",
+			zap.String("path", path),
+			zap.Error(err),
+		)
 	}
+}
 
-	key, err := state.Storage.Store(ctx, buf)
+// storeFile streams a file into blob storage, returning its key and size.
+func storeFile(ctx context.Context, storage blob.Storage, path string) (blob.Key, int64, error) {
+	f, err := os.Open(path)
 	if err != nil {
-		return Transmission{}, fmt.Errorf("unable to store %s from %q: %w", span, path, err)
+		return blob.Key{}, 0, fmt.Errorf("unable to read %q: %w", path, err)
 	}
+	defer f.Close()
 
-	transmission := Transmission{
-		TimeStamp: chunk.TimeStamp.Add(span.Start),
-		Length:    span.Duration(),
-		Sha256:    key.String(),
-		ChunkID:   chunk.ID,
+	info, err := f.Stat()
+	if err != nil {
+		return blob.Key{}, 0, fmt.Errorf("unable to read %q: %w", path, err)
 	}
 
-	if err := state.DB.Save(&transmission).Error; err != nil {
-		return Transmission{}, fmt.Errorf("unable to save transmission: %w", err)
+	key, err := storage.StoreReader(ctx, f)
+	if err != nil {
+		return blob.Key{}, 0, fmt.Errorf("unable to save %q to blob storage: %w", path, err)
 	}
 
-	state.Logger.Info(
-		"Saved transmission",
-		zap.Any("transmission", transmission),
-		zap.Int("bytes", len(buf)),
-	)
-
-	return transmission, nil
+	return key, info.Size(), nil
 }
 
 type audioSpan struct {
@@ -2,16 +2,18 @@ package radiochatter
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"path"
 	"sort"
 	"strings"
 	"testing"
 	"time"
 
-	"github.com/Michael-F-Bryan/radio-chatter/pkg/on_disk_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
-	"golang.org/x/sync/errgroup"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -27,10 +29,10 @@ func TestArchiveRealRecording(t *testing.T) {
 	input := testRecording(t)
 	temp := t.TempDir()
 	ch := make(chan ArchiveOperation)
-	cb := archiveCallbacks(ctx, ch, dummyNow)
+	cb := archiveCallbacks(ctx, ch, DefaultPreprocessOptions(), dummyNow)
 	go func() {
 		defer close(ch)
-		err := Preprocess(ctx, logger, input, temp, cb)
+		err := Preprocess(ctx, logger, input, temp, DefaultPreprocessOptions(), cb)
 		assert.NoError(t, err)
 	}()
 
@@ -39,49 +41,68 @@ func TestArchiveRealRecording(t *testing.T) {
 		ops = append(ops, op)
 	}
 
+	// Note: ffmpeg can only split chunks on packet boundaries, so we use the
+	// segment list to find out exactly where each chunk starts
+	if !assert.Len(t, ops, 3) {
+		return
+	}
+	second := ops[1].Timestamp.Sub(timestamp(0))
+	third := ops[2].Timestamp.Sub(timestamp(0))
+	assert.InDelta(t, float64(60*time.Second), float64(second), float64(time.Second))
+	assert.InDelta(t, float64(120*time.Second), float64(third), float64(time.Second))
+	assert.Equal(t, second, ops[0].Length)
+	assert.Equal(t, third-second, ops[1].Length)
 	assert.Equal(
 		t,
-		[]ArchiveOperation{
+		[][]audioSpan{
 			{
-				Path:      path.Join(temp, "chunk_0.mp3"),
-				Timestamp: timestamp(0),
-				Pieces: []audioSpan{
-					{Start: 18323800000, End: 22560400000},
-					{Start: 26447300000, End: 28632600000},
-					{Start: 29799600000, End: 58637000000},
-				},
+				{Start: 18323800000, End: 22560400000},
+				{Start: 26447300000, End: 28632600000},
+				{Start: 29799600000, End: 58637000000},
 			},
 			{
-				Path:      path.Join(temp, "chunk_1.mp3"),
-				Timestamp: timestamp(60 * time.Second),
-				Pieces: []audioSpan{
-					{Start: 1848000000, End: 9876800000},
-					{Start: 11608400000, End: 19490900000},
-					{Start: 22476299999, End: 24196100000},
-					{Start: 26355800000, End: 31028599999},
-					{Start: 32477800000, End: 32998500000},
-					{Start: 34763400000, End: 40691000000},
-					{Start: 41918000000, End: 60000000000},
-				},
+				{Start: 61848000000, End: 69876800000},
+				{Start: 71608400000, End: 79490900000},
+				{Start: 82476299999, End: 84196100000},
+				{Start: 86355800000, End: 91028599999},
+				{Start: 92477800000, End: 92998500000},
+				{Start: 94763400000, End: 100691000000},
+				{Start: 101918000000, End: third},
 			},
 			{
-				Path:      path.Join(temp, "chunk_2.mp3"),
-				Timestamp: timestamp(120 * time.Second),
-				Pieces: []audioSpan{
-					{Start: 0, End: 18446000000},
-					{Start: 21415000000, End: 23333000000},
-				},
+				{Start: third, End: 138446000000},
+				{Start: 141415000000, End: 143333000000},
 			},
 		},
-		ops,
+		absolutePieces(ops),
 	)
+	for i, op := range ops {
+		assert.Equal(t, path.Join(temp, fmt.Sprintf("chunk_%d.mp3", i)), op.Path)
+	}
+}
+
+// absolutePieces converts each operation's pieces so they are relative to the
+// start of the recording rather than the start of their chunk.
+func absolutePieces(ops []ArchiveOperation) [][]audioSpan {
+	var pieces [][]audioSpan
+
+	for _, op := range ops {
+		offset := op.Timestamp.Sub(timestamp(0))
+		var spans []audioSpan
+		for _, piece := range op.Pieces {
+			spans = append(spans, audioSpan{Start: piece.Start + offset, End: piece.End + offset})
+		}
+		pieces = append(pieces, spans)
+	}
+
+	return pieces
 }
 
 func TestReplayStderrToArchiver(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	ch := make(chan ArchiveOperation, 16)
 	ctx := testContext(t)
-	cb := archiveCallbacks(ctx, ch, dummyNow)
+	cb := archiveCallbacks(ctx, ch, DefaultPreprocessOptions(), dummyNow)
 
 	err := parseStderr(logger, strings.NewReader(stderr), cb)
 
@@ -98,6 +119,7 @@ func TestReplayStderrToArchiver(t *testing.T) {
 			{
 				Path:      "output000.mp3",
 				Timestamp: timestamp(0),
+				Length:    DefaultChunkLength,
 				Pieces: []audioSpan{
 					{Start: 19029900000, End: 24462600000},
 					{Start: 31306100000, End: 36254100000},
@@ -107,6 +129,7 @@ func TestReplayStderrToArchiver(t *testing.T) {
 			{
 				Path:      "output001.mp3",
 				Timestamp: timestamp(60 * time.Second),
+				Length:    DefaultChunkLength,
 				Pieces: []audioSpan{
 					{0, 5108099999},
 					{36096400000, 40403000000},
@@ -117,6 +140,7 @@ func TestReplayStderrToArchiver(t *testing.T) {
 			{
 				Path:      "output002.mp3",
 				Timestamp: timestamp(120 * time.Second),
+				Length:    DefaultChunkLength,
 			},
 		},
 		ops,
@@ -126,7 +150,7 @@ func TestReplayStderrToArchiver(t *testing.T) {
 func TestJustSilence(t *testing.T) {
 	ch := make(chan ArchiveOperation, 16)
 	ctx := testContext(t)
-	cb := archiveCallbacks(ctx, ch, dummyNow)
+	cb := archiveCallbacks(ctx, ch, DefaultPreprocessOptions(), dummyNow)
 
 	// First we start downloading
 	cb.onDownloadStarted()
@@ -152,10 +176,55 @@ func TestJustSilence(t *testing.T) {
 			{
 				Path:      "chunk_0.mp3",
 				Timestamp: timestamp(0),
+				Length:    DefaultChunkLength,
 			},
 			{
 				Path:      "chunk_1.mp3",
 				Timestamp: timestamp(60 * time.Second),
+				Length:    DefaultChunkLength,
+			},
+		},
+		ops,
+	)
+}
+
+func TestAudioSpanningCustomLengthChunks(t *testing.T) {
+	ch := make(chan ArchiveOperation, 16)
+	ctx := testContext(t)
+	opts := DefaultPreprocessOptions()
+	opts.ChunkLength = 30 * time.Second
+	cb := archiveCallbacks(ctx, ch, opts, dummyNow)
+
+	cb.onDownloadStarted()
+	cb.onStartWriting("chunk_0.mp3")
+	cb.onSilenceStart(0)
+	// Someone starts talking 20 seconds in
+	cb.onSilenceEnd(20*time.Second, 20*time.Second)
+	// and keeps going into the second chunk
+	cb.onStartWriting("chunk_1.mp3")
+	cb.onSilenceStart(40 * time.Second)
+	cb.onFinished()
+	close(ch)
+
+	var ops []ArchiveOperation
+	for op := range ch {
+		ops = append(ops, op)
+	}
+
+	assert.Equal(
+		t,
+		[]ArchiveOperation{
+			{
+				Path:      "chunk_0.mp3",
+				Timestamp: timestamp(0),
+				Length:    30 * time.Second,
+				Pieces:    []audioSpan{{Start: 20 * time.Second, End: 30 * time.Second}},
+			},
+			{
+				Path:      "chunk_1.mp3",
+				Timestamp: timestamp(30 * time.Second),
+				Length:    30 * time.Second,
+				Pieces:    []audioSpan{{Start: 0, End: 10 * time.Second}},
 			},
 		},
 		ops,
@@ -165,7 +234,7 @@ func TestJustSilence(t *testing.T) {
 func TestClipContainingAudio(t *testing.T) {
 	ch := make(chan ArchiveOperation, 16)
 	ctx := testContext(t)
-	cb := archiveCallbacks(ctx, ch, dummyNow)
+	cb := archiveCallbacks(ctx, ch, DefaultPreprocessOptions(), dummyNow)
 
 	// First we start downloading
 	cb.onDownloadStarted()
@@ -193,6 +262,7 @@ func TestClipContainingAudio(t *testing.T) {
 			{
 				Path:      "chunk_0.mp3",
 				Timestamp: timestamp(0),
+				Length:    DefaultChunkLength,
 				Pieces: []audioSpan{
 					{Start: 10 * time.Second, End: 15 * time.Second},
 				},
@@ -205,7 +275,7 @@ func TestClipContainingAudio(t *testing.T) {
 func TestAudioInSecondClip(t *testing.T) {
 	ch := make(chan ArchiveOperation, 16)
 	ctx := testContext(t)
-	cb := archiveCallbacks(ctx, ch, dummyNow)
+	cb := archiveCallbacks(ctx, ch, DefaultPreprocessOptions(), dummyNow)
 
 	// First we start downloading
 	cb.onDownloadStarted()
@@ -235,10 +305,12 @@ func TestAudioInSecondClip(t *testing.T) {
 			{
 				Path:      "chunk_0.mp3",
 				Timestamp: timestamp(0),
+				Length:    DefaultChunkLength,
 			},
 			{
 				Path:      "chunk_1.mp3",
 				Timestamp: timestamp(60 * time.Second),
+				Length:    DefaultChunkLength,
 				Pieces: []audioSpan{
 					{Start: 5 * time.Second, End: 10 * time.Second},
 				},
@@ -251,7 +323,7 @@ func TestAudioInSecondClip(t *testing.T) {
 func TestAudioAcrossChunkBoundary(t *testing.T) {
 	ch := make(chan ArchiveOperation, 16)
 	ctx := testContext(t)
-	cb := archiveCallbacks(ctx, ch, dummyNow)
+	cb := archiveCallbacks(ctx, ch, DefaultPreprocessOptions(), dummyNow)
 
 	// First we start downloading
 	cb.onDownloadStarted()
@@ -281,6 +353,7 @@ func TestAudioAcrossChunkBoundary(t *testing.T) {
 			{
 				Path:      "chunk_0.mp3",
 				Timestamp: timestamp(0),
+				Length:    DefaultChunkLength,
 				Pieces: []audioSpan{
 					{Start: 50 * time.Second, End: 60 * time.Second},
 				},
@@ -288,6 +361,7 @@ func TestAudioAcrossChunkBoundary(t *testing.T) {
 			{
 				Path:      "chunk_1.mp3",
 				Timestamp: timestamp(60 * time.Second),
+				Length:    DefaultChunkLength,
 				Pieces: []audioSpan{
 					{Start: 0 * time.Second, End: 5 * time.Second},
 				},
@@ -318,20 +392,13 @@ func TestSplitAndArchiveRecording(t *testing.T) {
 
 	logger := zaptest.NewLogger(t)
 	ctx := testContext(t)
-	group, ctx := errgroup.WithContext(ctx)
 	db := testDatabase(ctx, t)
 	stream := Stream{DisplayName: "Test", Url: testRecording(t)}
 	assert.NoError(t, db.Save(&stream).Error)
-	storage, err := on_disk_storage.New(logger, t.TempDir())
-	assert.NoError(t, err)
-	defer storage.Close()
+	storage := mem_storage.New()
 
-	archiveOps := make(chan ArchiveOperation)
-	temp, cleanup := mkdtemp(logger)
-	defer cleanup()
-	group.Go(preprocess(ctx, logger.Named("preprocess"), stream.Url, temp, archiveOps))
-	group.Go(archive(ctx, logger.Named("archive"), archiveOps, storage, db, stream))
-	assert.NoError(t, group.Wait())
+	err := runEpoch(ctx, logger, stream, stream.PreprocessOptions(), t.TempDir(), storage, db, PreprocessingCallbacks{})
+	assert.NoError(t, err)
 
 	assert.NoError(t, db.Preload("Chunks").Preload("Chunks.Transmissions").Find(&stream).Error)
 	assert.Equal(t, 3, len(stream.Chunks))
@@ -339,9 +406,99 @@ func TestSplitAndArchiveRecording(t *testing.T) {
 	assert.Equal(t, 7, len(transmisions))
 	sort.Slice(transmisions, func(i, j int) bool { return transmisions[i].TimeStamp.Before(transmisions[j].TimeStamp) })
 	transmission := stream.Chunks[1].Transmissions[0]
-	assert.Equal(t, "7d865c69589b323c95dcb2c5aab008559efcfe226284aa8a70db5d0c01f04e71", transmission.Sha256)
+	key, err := blob.ParseKey(transmission.Sha256)
+	assert.NoError(t, err)
+	assertBlobExists(ctx, t, storage, key)
 	assert.Equal(t, stream.Chunks[1].ID, transmission.ChunkID)
 	assert.Equal(t, 8028800*time.Microsecond, transmission.Length)
+	// Note: The exact chunk boundaries come from ffmpeg's segment list, so
+	// the chunks should be back-to-back and the transmission should start at
+	// the same point in the recording regardless of where the chunk was cut
+	recordingStarted := stream.Chunks[0].TimeStamp
+	assert.InDelta(t, float64(DefaultChunkLength), float64(stream.Chunks[0].Length), float64(time.Second))
+	assert.Equal(t, stream.Chunks[0].Length, stream.Chunks[1].TimeStamp.Sub(recordingStarted))
+	assert.Equal(t, 61848*time.Millisecond, transmission.TimeStamp.Sub(recordingStarted))
+}
+
+func TestArchivingWithDifferentCodecs(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	requires(t, ffmpegCommand)
+
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	stream := Stream{DisplayName: "Test", Url: testRecording(t), ChunkCodec: CodecOpus, TransmissionCodec: CodecWAV}
+	assert.NoError(t, db.Save(&stream).Error)
+	storage := mem_storage.New()
+
+	err := runEpoch(ctx, logger, stream, stream.PreprocessOptions(), t.TempDir(), storage, db, PreprocessingCallbacks{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, db.Preload("Chunks").Preload("Chunks.Transmissions").Find(&stream).Error)
+	assert.NotEmpty(t, stream.Chunks)
+	for _, chunk := range stream.Chunks {
+		assert.Equal(t, CodecOpus, chunk.Codec)
+		assert.Equal(t, "audio/ogg", chunk.MediaType)
+		for _, transmission := range chunk.Transmissions {
+			assert.Equal(t, CodecWAV, transmission.Codec)
+			assert.Equal(t, "audio/wav", transmission.MediaType)
+			key, err := blob.ParseKey(transmission.Sha256)
+			assert.NoError(t, err)
+			data, ok := storage.Get(key)
+			assert.True(t, ok)
+			assert.Equal(t, "audio/wav", blob.DetectMediaType(data))
+		}
+	}
+}
+
+func TestExecuteKeepsTheRawChunk(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	stream := Stream{DisplayName: "Test", Url: "...", AudioFilter: AudioFilterVoice, KeepRawChunks: true}
+	assert.NoError(t, db.Save(&stream).Error)
+	storage := mem_storage.New()
+	dir := t.TempDir()
+	chunkPath := path.Join(dir, "chunk_0.mp3")
+	assert.NoError(t, os.WriteFile(chunkPath, []byte("cleaned"), 0666))
+	rawPath := path.Join(dir, "raw_chunk_0.mp3")
+	assert.NoError(t, os.WriteFile(rawPath, []byte("raw"), 0666))
+	state := ArchiveState{Logger: logger, Storage: storage, DB: db, Stream: stream, Options: stream.PreprocessOptions()}
+	op := ArchiveOperation{Path: chunkPath, RawPath: rawPath, Timestamp: timestamp(0)}
+
+	err := op.Execute(ctx, state)
+
+	assert.NoError(t, err)
+	var chunk Chunk
+	assert.NoError(t, db.First(&chunk).Error)
+	assert.Equal(t, blob.KeyForBytes([]byte("cleaned")).String(), chunk.Sha256)
+	assert.Equal(t, blob.KeyForBytes([]byte("raw")).String(), chunk.RawSha256)
+	assert.Len(t, storage.Keys(), 2)
+	assert.NoFileExists(t, chunkPath)
+	assert.NoFileExists(t, rawPath)
+}
+
+func TestArchiverFindsTheRawChunks(t *testing.T) {
+	ch := make(chan ArchiveOperation, 16)
+	ctx := testContext(t)
+	opts := DefaultPreprocessOptions()
+	opts.AudioFilter = AudioFilterVoice
+	opts.KeepRawChunks = true
+	cb := archiveCallbacks(ctx, ch, opts, dummyNow)
+
+	cb.DownloadStarted()
+	cb.StartWriting("/tmp/chunk_20240301T100000Z.mp3")
+	cb.StartWriting("/tmp/chunk_20240301T100100Z.mp3")
+	cb.Finished()
+
+	close(ch)
+	var rawPaths []string
+	for op := range ch {
+		rawPaths = append(rawPaths, op.RawPath)
+	}
+	assert.Equal(t, []string{"/tmp/raw_chunk_0.mp3", "/tmp/raw_chunk_1.mp3"}, rawPaths)
 }
 
 func testDatabase(ctx context.Context, t *testing.T) *gorm.DB {
@@ -356,3 +513,189 @@ func testDatabase(ctx context.Context, t *testing.T) *gorm.DB {
 
 	return db
 }
+
+func TestExecuteStreamsChunkIntoStorage(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	stream := Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, db.Save(&stream).Error)
+	storage := mem_storage.New()
+	chunkPath := path.Join(t.TempDir(), "chunk_0.mp3")
+	assert.NoError(t, os.WriteFile(chunkPath, []byte("not really an mp3"), 0666))
+	state := ArchiveState{Logger: logger, Storage: storage, DB: db, Stream: stream, Options: stream.PreprocessOptions()}
+	op := ArchiveOperation{Path: chunkPath, Timestamp: timestamp(0)}
+
+	err := op.Execute(ctx, state)
+
+	assert.NoError(t, err)
+	var chunks []Chunk
+	assert.NoError(t, db.Find(&chunks).Error)
+	assert.Len(t, chunks, 1)
+	key := blob.KeyForBytes([]byte("not really an mp3"))
+	assert.Equal(t, key.String(), chunks[0].Sha256)
+	assert.Equal(t, CodecMP3, chunks[0].Codec)
+	assert.Equal(t, "audio/mpeg", chunks[0].MediaType)
+	assert.Equal(t, []blob.Key{key}, storage.Keys())
+	assert.NoFileExists(t, chunkPath)
+}
+
+func TestExecuteUsesTheResolvedOptions(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	stream := Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, db.Save(&stream).Error)
+	// The deployment defaults aren't stored on the stream
+	opts := DefaultPreprocessOptions()
+	opts.ChunkCodec = CodecOpus
+	state := ArchiveState{Logger: logger, Storage: mem_storage.New(), DB: db, Stream: stream, Options: opts}
+	chunkPath := path.Join(t.TempDir(), "chunk_0.ogg")
+	assert.NoError(t, os.WriteFile(chunkPath, []byte("not really an ogg"), 0666))
+	op := ArchiveOperation{Path: chunkPath, Timestamp: timestamp(0)}
+
+	err := op.Execute(ctx, state)
+
+	assert.NoError(t, err)
+	var chunk Chunk
+	assert.NoError(t, db.First(&chunk).Error)
+	assert.Equal(t, CodecOpus, chunk.Codec)
+	assert.Equal(t, "audio/ogg", chunk.MediaType)
+}
+
+func TestExecuteOnlySplitsChunksContainingAudio(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	stream := Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, db.Save(&stream).Error)
+	state := ArchiveState{Logger: logger, Storage: mem_storage.New(), DB: db, Stream: stream, Options: stream.PreprocessOptions()}
+	chunkPath := path.Join(t.TempDir(), "chunk_0.mp3")
+	assert.NoError(t, os.WriteFile(chunkPath, []byte("not really an mp3"), 0666))
+	op := ArchiveOperation{Path: chunkPath, Timestamp: timestamp(0)}
+
+	err := op.Execute(ctx, state)
+
+	assert.NoError(t, err)
+	var count int64
+	assert.NoError(t, db.Model(&Transmission{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
+
+func TestExecuteSplitsTheAudioIntoTransmissions(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	requires(t, ffmpegCommand)
+
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	stream := Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, db.Save(&stream).Error)
+	state := ArchiveState{Logger: logger, Storage: mem_storage.New(), DB: db, Stream: stream, Options: stream.PreprocessOptions()}
+	recording, err := os.ReadFile(testRecording(t))
+	assert.NoError(t, err)
+	chunkPath := path.Join(t.TempDir(), "chunk_0.mp3")
+	assert.NoError(t, os.WriteFile(chunkPath, recording, 0666))
+	op := ArchiveOperation{
+		Path:      chunkPath,
+		Timestamp: timestamp(0),
+		Pieces: []audioSpan{
+			{Start: 18323800000, End: 22560400000},
+			{Start: 26447300000, End: 28632600000},
+		},
+	}
+
+	err = op.Execute(ctx, state)
+
+	assert.NoError(t, err)
+	var transmissions []Transmission
+	assert.NoError(t, db.Order("time_stamp").Find(&transmissions).Error)
+	if assert.Len(t, transmissions, 2) {
+		assert.Equal(t, timestamp(18323800000), transmissions[0].TimeStamp)
+		assert.Equal(t, 4236600*time.Microsecond, transmissions[0].Length)
+		assert.Equal(t, timestamp(26447300000), transmissions[1].TimeStamp)
+	}
+}
+
+func TestChunkBoundariesComeFromTheSegmentList(t *testing.T) {
+	ch := make(chan ArchiveOperation, 16)
+	ctx := testContext(t)
+	cb := archiveCallbacks(ctx, ch, DefaultPreprocessOptions(), dummyNow)
+
+	cb.onDownloadStarted()
+	cb.onStartWriting("chunk_0.mp3")
+	cb.onSilenceStart(0)
+	// Someone starts talking just before the nominal end of the chunk
+	cb.onSilenceEnd(59*time.Second, 59*time.Second)
+	// ffmpeg can only split on packet boundaries, so the chunk runs long
+	cb.onSegmentComplete(Segment{Path: "chunk_0.mp3", Start: 0, End: 60048 * time.Millisecond})
+	cb.onStartWriting("chunk_1.mp3")
+	cb.onSilenceStart(61 * time.Second)
+	cb.onSegmentComplete(Segment{Path: "chunk_1.mp3", Start: 60048 * time.Millisecond, End: 90 * time.Second})
+	cb.onFinished()
+	close(ch)
+
+	var ops []ArchiveOperation
+	for op := range ch {
+		ops = append(ops, op)
+	}
+
+	assert.Equal(
+		t,
+		[]ArchiveOperation{
+			{
+				Path:      "chunk_0.mp3",
+				Timestamp: timestamp(0),
+				Length:    60048 * time.Millisecond,
+				Pieces:    []audioSpan{{Start: 59 * time.Second, End: 60048 * time.Millisecond}},
+			},
+			{
+				Path:      "chunk_1.mp3",
+				Timestamp: timestamp(60048 * time.Millisecond),
+				Length:    29952 * time.Millisecond,
+				Pieces:    []audioSpan{{Start: 0, End: 952 * time.Millisecond}},
+			},
+		},
+		ops,
+	)
+}
+
+func TestWallClockNamesCorrectDrift(t *testing.T) {
+	ch := make(chan ArchiveOperation, 16)
+	ctx := testContext(t)
+	started := time.Date(2024, 3, 1, 12, 0, 0, 500*int(time.Millisecond), time.UTC)
+	cb := archiveCallbacks(ctx, ch, DefaultPreprocessOptions(), func() time.Time { return started })
+
+	cb.onDownloadStarted()
+	cb.onStartWriting("chunk_20240301T120000Z.mp3")
+	cb.onSilenceStart(0)
+	cb.onSegmentComplete(Segment{Path: "chunk_20240301T120000Z.mp3", Start: 0, End: time.Minute})
+	// The stream was buffering for 10 seconds, so the audio's timeline has
+	// fallen behind the wall clock
+	cb.onStartWriting("chunk_20240301T120110Z.mp3")
+	cb.onSegmentComplete(Segment{Path: "chunk_20240301T120110Z.mp3", Start: time.Minute, End: 2 * time.Minute})
+	cb.onStartWriting("chunk_20240301T120210Z.mp3")
+	cb.onSegmentComplete(Segment{Path: "chunk_20240301T120210Z.mp3", Start: 2 * time.Minute, End: 3 * time.Minute})
+	cb.onFinished()
+	close(ch)
+
+	var timestamps []time.Time
+	for op := range ch {
+		timestamps = append(timestamps, op.Timestamp)
+	}
+
+	assert.Equal(
+		t,
+		[]time.Time{
+			// Accurate to the millisecond while the name agrees
+			started,
+			// Then the name is used to correct the drift
+			time.Date(2024, 3, 1, 12, 1, 10, 0, time.UTC),
+			// And later chunks count from the corrected time
+			time.Date(2024, 3, 1, 12, 2, 10, 0, time.UTC),
+		},
+		timestamps,
+	)
+}
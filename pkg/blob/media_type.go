@@ -0,0 +1,65 @@
+package blob
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// sniffLen is the maximum number of bytes DetectMediaType() looks at.
+const sniffLen = 512
+
+// DetectMediaType guesses a blob's media type from the first few hundred
+// bytes of its contents, falling back to "application/octet-stream".
+//
+// This builds on http.DetectContentType(), which only recognises MP3 files
+// that start with an ID3 tag and uses less common names for Ogg and WAV.
+func DetectMediaType(header []byte) string {
+	if len(header) > sniffLen {
+		header = header[:sniffLen]
+	}
+
+	mediaType := http.DetectContentType(header)
+	switch mediaType {
+	case "application/ogg":
+		// We only ever store audio, and browsers are happier with this
+		return "audio/ogg"
+	case "audio/wave":
+		return "audio/wav"
+	}
+	if mediaType != "application/octet-stream" {
+		return mediaType
+	}
+
+	// MPEG audio frames and ADTS (AAC) frames both start with an 11 or 12 bit
+	// sync word, and ADTS always sets the "layer" bits to zero.
+	if len(header) >= 2 && header[0] == 0xFF && header[1]&0xE0 == 0xE0 {
+		if header[1]&0x06 == 0 {
+			return "audio/aac"
+		}
+		return "audio/mpeg"
+	}
+
+	if len(header) >= 4 && string(header[:4]) == "fLaC" {
+		return "audio/flac"
+	}
+
+	return mediaType
+}
+
+// SniffMediaType reads the start of a file to detect its media type, then
+// rewinds it.
+func SniffMediaType(r io.ReadSeeker) (string, error) {
+	header := make([]byte, sniffLen)
+
+	n, err := io.ReadFull(r, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", fmt.Errorf("unable to read the header: %w", err)
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("unable to rewind: %w", err)
+	}
+
+	return DetectMediaType(header[:n]), nil
+}
@@ -0,0 +1,40 @@
+package blob
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectMediaType(t *testing.T) {
+	inputs := map[string]string{
+		"ID3\x04\x00\x00\x00\x00\x00\x00": "audio/mpeg",
+		"\xFF\xFB\x90\x64\x00":            "audio/mpeg",
+		"\xFF\xF1\x50\x80\x00":            "audio/aac",
+		"fLaC\x00\x00\x00\x22":            "audio/flac",
+		"OggS\x00\x02\x00\x00":            "audio/ogg",
+		"RIFF\x24\x00\x00\x00WAVEfmt ":    "audio/wav",
+		"Hello, World":                    "text/plain; charset=utf-8",
+		"\x00\x01\x02\x03":                "application/octet-stream",
+	}
+
+	for input, expected := range inputs {
+		got := DetectMediaType([]byte(input))
+
+		assert.Equal(t, expected, got, "%q", input)
+	}
+}
+
+func TestSniffMediaTypeRewinds(t *testing.T) {
+	r := strings.NewReader("ID3\x04\x00 and the rest of the file")
+
+	mediaType, err := SniffMediaType(r)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "audio/mpeg", mediaType)
+	rest, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "ID3\x04\x00 and the rest of the file", string(rest))
+}
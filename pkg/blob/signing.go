@@ -0,0 +1,98 @@
+package blob
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrMissingSignature = errors.New("the link isn't signed")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrLinkExpired      = errors.New("the link has expired")
+)
+
+const (
+	expiresParam   = "expires"
+	signatureParam = "signature"
+)
+
+// LinkSigner generates links that can't be tampered with and which stop
+// working after a certain amount of time.
+//
+// Links are signed using a HMAC-SHA256 of the blob's key and the link's expiry
+// time, so anyone who knows the secret can verify a link.
+type LinkSigner struct {
+	secret []byte
+	now    func() time.Time
+}
+
+// NewLinkSigner creates a LinkSigner which uses the provided secret.
+func NewLinkSigner(secret []byte) *LinkSigner {
+	return &LinkSigner{
+		secret: secret,
+		now:    time.Now,
+	}
+}
+
+// RandomSecret generates a new secret that can be passed to NewLinkSigner().
+func RandomSecret() ([]byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("unable to generate a secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+// Sign will add an expiry time and signature to the query string of a link to
+// the provided blob.
+func (s *LinkSigner) Sign(link *url.URL, key Key, validFor time.Duration) *url.URL {
+	expires := s.now().Add(validFor).Unix()
+
+	signed := *link
+	query := signed.Query()
+	query.Set(expiresParam, strconv.FormatInt(expires, 10))
+	query.Set(signatureParam, s.signature(key, expires))
+	signed.RawQuery = query.Encode()
+
+	return &signed
+}
+
+// Verify checks that the query string from a link contains a valid signature
+// for the provided blob and that the link hasn't expired.
+func (s *LinkSigner) Verify(key Key, query url.Values) error {
+	rawExpires := query.Get(expiresParam)
+	rawSignature := query.Get(signatureParam)
+	if rawExpires == "" || rawSignature == "" {
+		return ErrMissingSignature
+	}
+
+	expires, err := strconv.ParseInt(rawExpires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	expected := s.signature(key, expires)
+	if !hmac.Equal([]byte(expected), []byte(rawSignature)) {
+		return ErrInvalidSignature
+	}
+
+	if s.now().After(time.Unix(expires, 0)) {
+		return ErrLinkExpired
+	}
+
+	return nil
+}
+
+func (s *LinkSigner) signature(key Key, expires int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s\n%d", key, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,43 @@
+package blob
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignedLinksRoundTrip(t *testing.T) {
+	signer := NewLinkSigner([]byte("secret"))
+	key := KeyForBytes([]byte("Hello, World"))
+	base, _ := url.Parse("http://localhost/" + key.String())
+
+	link := signer.Sign(base, key, time.Hour)
+
+	assert.NoError(t, signer.Verify(key, link.Query()))
+}
+
+func TestVerifyRejectsBadLinks(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	signer := NewLinkSigner([]byte("secret"))
+	signer.now = func() time.Time { return now }
+	key := KeyForBytes([]byte("Hello, World"))
+	base, _ := url.Parse("http://localhost/" + key.String())
+	valid := signer.Sign(base, key, time.Hour).Query()
+
+	tampered := url.Values{}
+	tampered.Set(expiresParam, valid.Get(expiresParam)+"0")
+	tampered.Set(signatureParam, valid.Get(signatureParam))
+
+	otherSecret := NewLinkSigner([]byte("other secret"))
+	otherSecret.now = signer.now
+
+	expired := signer.Sign(base, key, -time.Second).Query()
+
+	assert.ErrorIs(t, signer.Verify(key, url.Values{}), ErrMissingSignature)
+	assert.ErrorIs(t, signer.Verify(key, tampered), ErrInvalidSignature)
+	assert.ErrorIs(t, signer.Verify(KeyForBytes(nil), valid), ErrInvalidSignature)
+	assert.ErrorIs(t, otherSecret.Verify(key, valid), ErrInvalidSignature)
+	assert.ErrorIs(t, signer.Verify(key, expired), ErrLinkExpired)
+}
@@ -0,0 +1,51 @@
+package blob
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+)
+
+// TempFilePrefix is the prefix used when naming the temporary files created by
+// Spool().
+const TempFilePrefix = ".spool-"
+
+// Spool copies the contents of a reader to a temporary file in the provided
+// directory (os.TempDir() if empty), calculating its key along the way.
+//
+// The returned file will be positioned at the start so it can be read from
+// immediately. The caller is responsible for closing and removing it.
+func Spool(dir string, r io.Reader) (f *os.File, key Key, size int64, err error) {
+	f, err = os.CreateTemp(dir, TempFilePrefix+"*")
+	if err != nil {
+		return nil, Key{}, 0, fmt.Errorf("unable to create a temporary file: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+			f = nil
+		}
+	}()
+
+	hasher := sha256.New()
+
+	size, err = io.Copy(io.MultiWriter(f, hasher), r)
+	if err != nil {
+		return nil, Key{}, 0, fmt.Errorf("unable to write to %q: %w", f.Name(), err)
+	}
+
+	if err := f.Sync(); err != nil {
+		return nil, Key{}, 0, fmt.Errorf("flushing %q failed: %w", f.Name(), err)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, Key{}, 0, fmt.Errorf("unable to rewind %q: %w", f.Name(), err)
+	}
+
+	copy(key[:], hasher.Sum(nil))
+
+	return f, key, size, nil
+}
@@ -0,0 +1,25 @@
+package blob
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpoolHashesWhileCopying(t *testing.T) {
+	content := "Hello, World"
+
+	f, key, size, err := Spool(t.TempDir(), strings.NewReader(content))
+
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	assert.Equal(t, KeyForBytes([]byte(content)), key)
+	assert.Equal(t, int64(len(content)), size)
+	written, err := io.ReadAll(f)
+	assert.NoError(t, err)
+	assert.Equal(t, content, string(written))
+}
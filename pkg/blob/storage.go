@@ -12,6 +12,10 @@ import (
 
 var ErrNotFound = errors.New("blob not found")
 
+// ErrCorrupt is returned when a blob can't be read because its contents have
+// been damaged or tampered with.
+var ErrCorrupt = errors.New("blob is corrupt")
+
 // Storage is a content-addressable storage layer.
 //
 // All methods should be goroutine-safe.
@@ -25,6 +29,46 @@ type Storage interface {
 	// Store will upload a blob to blob storage, returning the key it is stored
 	// under.
 	Store(ctx context.Context, blob []byte) (Key, error)
+
+	// StoreReader will stream a blob into blob storage, returning the key it is
+	// stored under.
+	//
+	// Unlike Store(), the blob doesn't need to be held in memory.
+	StoreReader(ctx context.Context, r io.Reader) (Key, error)
+
+	// Open a blob for reading, returning ErrNotFound if it doesn't exist.
+	Open(ctx context.Context, key Key) (io.ReadCloser, error)
+
+	// List calls fn for every blob in storage, in ascending order by key.
+	//
+	// Iteration stops as soon as fn returns an error, and that error is
+	// returned to the caller.
+	List(ctx context.Context, fn func(Info) error) error
+
+	// Delete a blob, returning ErrNotFound if it doesn't exist.
+	Delete(ctx context.Context, key Key) error
+}
+
+// KeyedStorage is implemented by backends which can save a blob under a key
+// chosen by the caller instead of the hash of its contents.
+//
+// This lets wrappers transform a blob (e.g. by encrypting it) while keeping
+// the key derived from the original contents.
+type KeyedStorage interface {
+	Storage
+
+	// StoreAt saves a blob under the provided key, replacing any blob which
+	// already exists.
+	StoreAt(ctx context.Context, key Key, r io.Reader) error
+}
+
+// Info contains information about a stored blob.
+type Info struct {
+	Key Key
+	// The blob's size in bytes.
+	Size int64
+	// When the blob was last written to.
+	LastModified time.Time
 }
 
 type Key [sha256.Size]byte
@@ -33,6 +77,20 @@ func (b Key) String() string {
 	return hex.EncodeToString(b[:])
 }
 
+func (b Key) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *Key) UnmarshalText(text []byte) error {
+	key, err := ParseKey(string(text))
+	if err != nil {
+		return err
+	}
+
+	*b = key
+	return nil
+}
+
 func ParseKey(key string) (Key, error) {
 	var buffer [sha256.Size]byte
 
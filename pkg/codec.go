@@ -0,0 +1,70 @@
+package radiochatter
+
+import "fmt"
+
+// AudioCodec is the format chunks and transmissions are saved in.
+type AudioCodec string
+
+const (
+	// MP3 is understood by everything.
+	CodecMP3 AudioCodec = "mp3"
+	// Opus in an Ogg container gives much better quality per byte for
+	// speech.
+	CodecOpus AudioCodec = "opus"
+	// Uncompressed 16-bit PCM in a WAV container, which is what Whisper
+	// works with internally.
+	CodecWAV AudioCodec = "wav"
+)
+
+func ParseAudioCodec(raw string) (AudioCodec, error) {
+	switch codec := AudioCodec(raw); codec {
+	case "", CodecMP3:
+		return CodecMP3, nil
+	case CodecOpus, "ogg":
+		return CodecOpus, nil
+	case CodecWAV:
+		return codec, nil
+	default:
+		return "", fmt.Errorf("unknown audio codec, %q, expected one of %s, %s, %s", raw, CodecMP3, CodecOpus, CodecWAV)
+	}
+}
+
+// Extension gets the file extension (without a leading ".") used for files
+// encoded with this codec.
+func (c AudioCodec) Extension() string {
+	switch c {
+	case CodecOpus:
+		return "ogg"
+	case CodecWAV:
+		return "wav"
+	default:
+		return "mp3"
+	}
+}
+
+// MediaType gets the MIME type used when serving files encoded with this
+// codec.
+func (c AudioCodec) MediaType() string {
+	switch c {
+	case CodecOpus:
+		return "audio/ogg"
+	case CodecWAV:
+		return "audio/wav"
+	default:
+		return "audio/mpeg"
+	}
+}
+
+// encoderArgs gets the ffmpeg arguments used to encode an output with this
+// codec.
+func (c AudioCodec) encoderArgs() []string {
+	switch c {
+	case CodecOpus:
+		// Note: Opus's "voip" mode is tuned for speech intelligibility
+		return []string{"-c:a", "libopus", "-b:a", "24k", "-application", "voip"}
+	case CodecWAV:
+		return []string{"-c:a", "pcm_s16le"}
+	default:
+		return []string{"-c:a", "libmp3lame"}
+	}
+}
@@ -0,0 +1,41 @@
+package radiochatter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAudioCodec(t *testing.T) {
+	inputs := map[string]AudioCodec{
+		"":     CodecMP3,
+		"mp3":  CodecMP3,
+		"opus": CodecOpus,
+		"ogg":  CodecOpus,
+		"wav":  CodecWAV,
+	}
+
+	for input, expected := range inputs {
+		got, err := ParseAudioCodec(input)
+
+		assert.NoError(t, err, "%q", input)
+		assert.Equal(t, expected, got, "%q", input)
+	}
+
+	_, err := ParseAudioCodec("flac")
+	assert.Error(t, err)
+}
+
+func TestTransmissionsUseTheChunkCodecByDefault(t *testing.T) {
+	opts := Stream{ChunkCodec: CodecOpus}.PreprocessOptions()
+
+	assert.NoError(t, opts.Validate())
+	assert.Equal(t, CodecOpus, opts.ChunkCodec)
+	assert.Equal(t, CodecOpus, opts.TransmissionCodec)
+
+	opts = Stream{ChunkCodec: CodecOpus, TransmissionCodec: CodecWAV}.PreprocessOptions()
+
+	assert.Equal(t, CodecWAV, opts.TransmissionCodec)
+	assert.Equal(t, "wav", opts.TransmissionCodec.Extension())
+	assert.Equal(t, "audio/wav", opts.TransmissionCodec.MediaType())
+}
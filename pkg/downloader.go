@@ -0,0 +1,453 @@
+package radiochatter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Downloader records streams, supervising each one independently so a
+// single bad stream can't stop the others from being recorded.
+type Downloader struct {
+	logger  *zap.Logger
+	db      *gorm.DB
+	storage blob.Storage
+	// Settings used when a stream doesn't override them.
+	Defaults PreprocessOptions
+	// How long to wait before restarting a stream.
+	Backoff Backoff
+	// How often to save each stream's health.
+	HeartbeatInterval time.Duration
+	// How long a stream can go without receiving audio before it counts as
+	// an outage.
+	StallTimeout time.Duration
+
+	mu       sync.Mutex
+	statuses map[uint]StreamStatus
+}
+
+func NewDownloader(logger *zap.Logger, db *gorm.DB, storage blob.Storage) *Downloader {
+	return &Downloader{
+		logger:            logger,
+		db:                db,
+		storage:           storage,
+		Defaults:          DefaultPreprocessOptions(),
+		Backoff:           DefaultBackoff(),
+		HeartbeatInterval: DefaultHeartbeatInterval,
+		StallTimeout:      DefaultStallTimeout,
+		statuses:          make(map[uint]StreamStatus),
+	}
+}
+
+// DefaultHeartbeatInterval is how often the downloader saves each stream's
+// health.
+const DefaultHeartbeatInterval = 10 * time.Second
+
+// DefaultWatchInterval is how often the downloader checks for changes to the
+// streams table.
+const DefaultWatchInterval = 30 * time.Second
+
+// Run records a fixed set of streams until the context is cancelled or every
+// stream has stopped.
+//
+// Problems with individual streams are handled by their restart policies, so
+// an error is only returned when something prevents every stream from being
+// recorded.
+func (d *Downloader) Run(ctx context.Context, streams []Stream) error {
+	temp, cleanup, err := d.tempDir()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	running := make(map[uint]*runningStream)
+	for _, stream := range streams {
+		running[stream.ID] = d.start(ctx, stream, temp)
+	}
+
+	for _, r := range running {
+		<-r.done
+	}
+
+	return nil
+}
+
+// Watch records every stream in the database until the context is cancelled,
+// checking for changes to the streams table every so often.
+//
+// New streams are started, deleted streams are stopped (after saving any
+// audio that has already been recorded), and streams are restarted whenever
+// their URL or processing settings change.
+func (d *Downloader) Watch(ctx context.Context, interval time.Duration) error {
+	temp, cleanup, err := d.tempDir()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	running := make(map[uint]*runningStream)
+	defer func() {
+		for id := range running {
+			d.stop(running, id)
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		var streams []Stream
+		if err := d.db.WithContext(ctx).Find(&streams).Error; err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			// Note: We'll try again next time
+			d.logger.Warn("Unable to load the streams", zap.Error(err))
+		} else {
+			d.sync(ctx, running, streams, temp)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// sync starts, stops, and restarts streams so the running set matches the
+// database.
+func (d *Downloader) sync(ctx context.Context, running map[uint]*runningStream, streams []Stream, temp string) {
+	seen := make(map[uint]struct{})
+
+	for _, stream := range streams {
+		seen[stream.ID] = struct{}{}
+		settings := d.settings(stream)
+
+		if r, ok := running[stream.ID]; ok {
+			if r.settings == settings {
+				continue
+			}
+
+			d.logger.Info(
+				"Restarting stream because its settings changed",
+				zap.Uint("stream-id", stream.ID),
+				zap.String("stream-name", stream.DisplayName),
+			)
+			d.stop(running, stream.ID)
+		} else {
+			d.logger.Info(
+				"Starting stream",
+				zap.Uint("stream-id", stream.ID),
+				zap.String("stream-name", stream.DisplayName),
+			)
+		}
+
+		running[stream.ID] = d.start(ctx, stream, temp)
+	}
+
+	for id := range running {
+		if _, ok := seen[id]; !ok {
+			d.logger.Info("Stopping stream because it was removed", zap.Uint("stream-id", id))
+			d.stop(running, id)
+
+			d.mu.Lock()
+			delete(d.statuses, id)
+			d.mu.Unlock()
+
+			db := d.db.WithContext(context.WithoutCancel(ctx))
+			if err := db.Delete(&StreamStatus{StreamID: id}).Error; err != nil {
+				d.logger.Warn("Unable to remove the stream's status", zap.Uint("stream-id", id), zap.Error(err))
+			}
+			if err := db.Delete(&StreamHealth{StreamID: id}).Error; err != nil {
+				d.logger.Warn("Unable to remove the stream's health", zap.Uint("stream-id", id), zap.Error(err))
+			}
+		}
+	}
+}
+
+// processingSettings are the parts of a stream which require a restart when
+// they change.
+type processingSettings struct {
+	Url           string
+	Options       PreprocessOptions
+	KeepRawChunks bool
+	RestartPolicy RestartPolicy
+	MaxFailures   int
+}
+
+func (d *Downloader) settings(stream Stream) processingSettings {
+	return processingSettings{
+		Url:           stream.Url,
+		Options:       stream.PreprocessOptionsWithDefaults(d.Defaults),
+		KeepRawChunks: stream.KeepRawChunks,
+		RestartPolicy: stream.RestartPolicy,
+		MaxFailures:   stream.MaxFailures,
+	}
+}
+
+type runningStream struct {
+	settings processingSettings
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func (d *Downloader) start(ctx context.Context, stream Stream, temp string) *runningStream {
+	ctx, cancel := context.WithCancel(ctx)
+	r := &runningStream{
+		settings: d.settings(stream),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+
+	go func() {
+		defer close(r.done)
+		d.runStream(ctx, stream, filepath.Join(temp, fmt.Sprint(stream.ID)))
+	}()
+
+	return r
+}
+
+// stop gracefully stops a stream and waits for it to finish.
+func (d *Downloader) stop(running map[uint]*runningStream, id uint) {
+	r := running[id]
+	r.cancel()
+	<-r.done
+	delete(running, id)
+}
+
+func (d *Downloader) tempDir() (string, func(), error) {
+	temp, err := os.MkdirTemp("", "radio-chatter-tmp")
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to create a temporary directory: %w", err)
+	}
+
+	d.logger.Debug("Saving clips to a temporary directory", zap.String("path", temp))
+
+	cleanup := func() {
+		if err := os.RemoveAll(temp); err != nil {
+			d.logger.Error("Unable to remove the temporary directory", zap.String("temp", temp), zap.Error(err))
+		}
+	}
+
+	return temp, cleanup, nil
+}
+
+// Statuses gets the latest status for every stream, ordered by stream ID.
+func (d *Downloader) Statuses() []StreamStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var statuses []StreamStatus
+	for _, status := range d.statuses {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].StreamID < statuses[j].StreamID })
+
+	return statuses
+}
+
+func (d *Downloader) runStream(ctx context.Context, stream Stream, dir string) {
+	logger := d.logger.With(
+		zap.Uint("stream-id", stream.ID),
+		zap.String("stream-name", stream.DisplayName),
+	)
+	opts := stream.PreprocessOptionsWithDefaults(d.Defaults)
+	// Note: Outages need to be recorded even when we're shutting down
+	outages := newOutageTracker(logger, d.db.WithContext(context.WithoutCancel(ctx)), stream.ID)
+	hb := &heartbeat{
+		interval:     d.HeartbeatInterval,
+		stallTimeout: d.StallTimeout,
+		onStalled: func(since time.Time) {
+			outages.Stopped(since, "no audio received")
+		},
+		onResumed: outages.Resumed,
+		save: func(health StreamHealth) {
+			health.StreamID = stream.ID
+			// Note: We want the final heartbeat even when shutting down
+			db := d.db.WithContext(context.WithoutCancel(ctx))
+			if err := db.Save(&health).Error; err != nil {
+				logger.Warn("Unable to save the stream's health", zap.Any("health", health), zap.Error(err))
+			}
+		},
+	}
+
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	watching := make(chan struct{})
+	go func() {
+		defer close(watching)
+		hb.watch(watchCtx, time.Now)
+	}()
+	defer func() {
+		stopWatching()
+		<-watching
+	}()
+
+	observers := PreprocessingCallbacks{
+		DownloadStarted: func() {
+			outages.Resumed(time.Now())
+		},
+		InputInfo: func(info InputInfo) {
+			changed, err := recordSourceMetadata(d.db.WithContext(ctx), stream.ID, info)
+			if err != nil {
+				logger.Warn("Unable to save the stream's source metadata", zap.Any("info", info), zap.Error(err))
+			} else if changed {
+				logger.Info("The stream's source metadata changed", zap.Any("info", info))
+			}
+		},
+		Progress: hb.onProgress,
+	}
+
+	s := newSupervisor(logger, d.Backoff, func(ctx context.Context, epoch int) error {
+		epochDir := filepath.Join(dir, fmt.Sprintf("epoch-%d", epoch))
+		return runEpoch(ctx, logger.With(zap.Int("epoch", epoch)), stream, opts, epochDir, d.storage, d.db, observers)
+	})
+	s.onStopped = outages.Stopped
+	s.policy = stream.RestartPolicy
+	s.maxFailures = stream.MaxFailures
+
+	s.onReconnect = func(r Reconnect) {
+		r.StreamID = stream.ID
+		if err := d.db.WithContext(ctx).Create(&r).Error; err != nil {
+			logger.Warn("Unable to record the reconnect", zap.Any("reconnect", r), zap.Error(err))
+		}
+	}
+	s.onStatus = func(status StreamStatus) {
+		status.StreamID = stream.ID
+		d.setStatus(ctx, logger, status)
+	}
+
+	if err := s.Run(ctx); err != nil {
+		logger.Error("Stopped recording the stream", zap.Error(err))
+	}
+}
+
+func (d *Downloader) setStatus(ctx context.Context, logger *zap.Logger, status StreamStatus) {
+	d.mu.Lock()
+	d.statuses[status.StreamID] = status
+	d.mu.Unlock()
+
+	// Note: We still want to record that the stream stopped when we're
+	// shutting down.
+	ctx = context.WithoutCancel(ctx)
+
+	if err := d.db.WithContext(ctx).Save(&status).Error; err != nil {
+		logger.Warn("Unable to save the stream's status", zap.Any("status", status), zap.Error(err))
+	}
+}
+
+// recordSourceMetadata saves the metadata from an input header, but only if it
+// is different from what we saw last time.
+func recordSourceMetadata(db *gorm.DB, streamID uint, info InputInfo) (bool, error) {
+	metadata := NewSourceMetadata(info)
+	metadata.StreamID = streamID
+
+	var latest SourceMetadata
+	err := db.Where("stream_id = ?", streamID).Order("id DESC").First(&latest).Error
+	if err == nil && latest.SameAs(metadata) {
+		return false, nil
+	} else if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+
+	if err := db.Create(&metadata).Error; err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// heartbeat turns ffmpeg's progress reports into periodic StreamHealth
+// updates, noticing when the stream stops sending audio.
+type heartbeat struct {
+	interval     time.Duration
+	save         func(health StreamHealth)
+	stallTimeout time.Duration
+	// Called when no audio has been received since the provided time.
+	onStalled func(since time.Time)
+	// Called when audio starts arriving again after a stall.
+	onResumed func(at time.Time)
+
+	mu      sync.Mutex
+	health  StreamHealth
+	saved   time.Time
+	stalled bool
+}
+
+func (h *heartbeat) onProgress(p Progress) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Note: ffmpeg starts counting from zero again whenever it is restarted
+	if p.OutTime > 0 && p.OutTime != h.health.AudioTime {
+		received := p.Timestamp
+		h.health.LastAudioAt = &received
+
+		if h.stalled {
+			h.stalled = false
+			if h.onResumed != nil {
+				h.onResumed(received)
+			}
+		}
+	} else {
+		h.checkForStall(p.Timestamp)
+	}
+
+	h.health.UpdatedAt = p.Timestamp
+	h.health.AudioTime = p.OutTime
+	h.health.Bitrate = p.Bitrate
+	h.health.Speed = p.Speed
+	h.health.Drift = p.Drift
+
+	if p.Finished || p.Timestamp.Sub(h.saved) >= h.interval {
+		h.saved = p.Timestamp
+		h.save(h.health)
+	}
+}
+
+// watch checks for stalls every interval until the context is cancelled.
+//
+// Note: ffmpeg can stop reporting progress altogether (e.g. while it waits on
+// a dead connection), so we can't rely on onProgress to notice.
+func (h *heartbeat) watch(ctx context.Context, now func() time.Time) {
+	if h.stallTimeout <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.mu.Lock()
+			h.checkForStall(now())
+			h.mu.Unlock()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkForStall triggers onStalled if no audio has been received for too long.
+// The caller must hold the lock.
+func (h *heartbeat) checkForStall(now time.Time) {
+	last := h.health.LastAudioAt
+	if last == nil || h.stalled || h.stallTimeout <= 0 || now.Sub(*last) < h.stallTimeout {
+		return
+	}
+
+	h.stalled = true
+	if h.onStalled != nil {
+		h.onStalled(*last)
+	}
+}
@@ -0,0 +1,236 @@
+package radiochatter
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestDownloaderIsolatesBrokenStreams(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	missing := filepath.Join(t.TempDir(), "missing.mp3")
+	first := Stream{DisplayName: "First", Url: missing, MaxFailures: 1}
+	assert.NoError(t, db.Save(&first).Error)
+	second := Stream{DisplayName: "Second", Url: missing, MaxFailures: 3}
+	assert.NoError(t, db.Save(&second).Error)
+	downloader := NewDownloader(logger, db, mem_storage.New())
+	downloader.Backoff = fastBackoff()
+
+	err := downloader.Run(ctx, []Stream{first, second})
+
+	assert.NoError(t, err)
+	statuses := downloader.Statuses()
+	if assert.Len(t, statuses, 2) {
+		assert.Equal(t, first.ID, statuses[0].StreamID)
+		assert.Equal(t, StreamFailed, statuses[0].State)
+		assert.Equal(t, 1, statuses[0].Failures)
+		assert.Equal(t, second.ID, statuses[1].StreamID)
+		assert.Equal(t, StreamFailed, statuses[1].State)
+		assert.Equal(t, 3, statuses[1].Failures)
+		assert.NotEmpty(t, statuses[1].LastError)
+	}
+	var saved []StreamStatus
+	assert.NoError(t, db.Order("stream_id").Find(&saved).Error)
+	assert.Len(t, saved, 2)
+	var reconnects int64
+	assert.NoError(t, db.Model(&Reconnect{}).Where("stream_id = ?", second.ID).Count(&reconnects).Error)
+	assert.Equal(t, int64(2), reconnects)
+}
+
+func TestDownloaderSyncStartsStopsAndRestartsStreams(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	temp := t.TempDir()
+	missing := filepath.Join(temp, "missing.mp3")
+	first := Stream{DisplayName: "First", Url: missing, MaxFailures: 1}
+	assert.NoError(t, db.Save(&first).Error)
+	second := Stream{DisplayName: "Second", Url: missing, MaxFailures: 1}
+	assert.NoError(t, db.Save(&second).Error)
+	downloader := NewDownloader(logger, db, mem_storage.New())
+	downloader.Backoff = fastBackoff()
+	running := make(map[uint]*runningStream)
+	defer func() {
+		for id := range running {
+			downloader.stop(running, id)
+		}
+	}()
+
+	// New streams get started
+	downloader.sync(ctx, running, []Stream{first}, temp)
+	assert.Len(t, running, 1)
+	original := running[first.ID]
+	<-original.done
+
+	// A stream that has given up isn't restarted when nothing changed
+	downloader.sync(ctx, running, []Stream{first}, temp)
+	assert.Same(t, original, running[first.ID])
+
+	// Changing the processing settings restarts it
+	first.NoiseThreshold = -40
+	downloader.sync(ctx, running, []Stream{first, second}, temp)
+	assert.Len(t, running, 2)
+	assert.NotSame(t, original, running[first.ID])
+
+	// Removed streams get stopped and forgotten
+	<-running[second.ID].done
+	downloader.sync(ctx, running, []Stream{first}, temp)
+	assert.Len(t, running, 1)
+	assert.NotContains(t, running, second.ID)
+	<-running[first.ID].done
+	statuses := downloader.Statuses()
+	if assert.Len(t, statuses, 1) {
+		assert.Equal(t, first.ID, statuses[0].StreamID)
+	}
+	var saved int64
+	assert.NoError(t, db.Model(&StreamStatus{}).Where("stream_id = ?", second.ID).Count(&saved).Error)
+	assert.Equal(t, int64(0), saved)
+}
+
+func TestDownloaderWatchPicksUpNewStreams(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx, cancel := context.WithCancel(testContext(t))
+	defer cancel()
+	db := testDatabase(ctx, t)
+	downloader := NewDownloader(logger, db, mem_storage.New())
+	downloader.Backoff = fastBackoff()
+	done := make(chan error)
+	go func() { done <- downloader.Watch(ctx, 10*time.Millisecond) }()
+
+	stream := Stream{DisplayName: "Late", Url: filepath.Join(t.TempDir(), "missing.mp3"), MaxFailures: 1}
+	assert.NoError(t, db.Save(&stream).Error)
+
+	assert.Eventually(t, func() bool {
+		statuses := downloader.Statuses()
+		return len(statuses) == 1 && statuses[0].State == StreamFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestHeartbeatsAreSavedPeriodically(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	var saved []StreamHealth
+	hb := heartbeat{
+		interval: 10 * time.Second,
+		save:     func(health StreamHealth) { saved = append(saved, health) },
+	}
+
+	for i := 0; i <= 25; i++ {
+		hb.onProgress(Progress{
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			// The stream stalls after 15 seconds
+			OutTime: time.Duration(min(i, 15)) * time.Second,
+			Speed:   0.5,
+		})
+	}
+	hb.onProgress(Progress{Timestamp: start.Add(26 * time.Second), OutTime: 15 * time.Second, Finished: true})
+
+	if assert.Len(t, saved, 4) {
+		assert.Equal(t, start, saved[0].UpdatedAt)
+		assert.Nil(t, saved[0].LastAudioAt)
+		assert.Equal(t, start.Add(10*time.Second), *saved[1].LastAudioAt)
+		assert.True(t, saved[1].Lagging())
+		assert.Equal(t, start.Add(15*time.Second), *saved[2].LastAudioAt)
+		assert.Equal(t, start.Add(20*time.Second), saved[2].UpdatedAt)
+		assert.Equal(t, start.Add(15*time.Second), *saved[3].LastAudioAt)
+		assert.False(t, saved[3].Lagging())
+	}
+}
+
+func TestSourceMetadataIsOnlySavedWhenItChanges(t *testing.T) {
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	stream := Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, db.Save(&stream).Error)
+	info := InputInfo{
+		Format:     "mp3",
+		Bitrate:    8,
+		Codec:      "mp3",
+		SampleRate: 8000,
+		Channels:   "mono",
+		Metadata:   map[string]string{"icy-name": "Fire Dispatch", "icy-genre": "Public Safety"},
+	}
+
+	changed, err := recordSourceMetadata(db, stream.ID, info)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	// Reconnecting to the same feed doesn't add to the history
+	changed, err = recordSourceMetadata(db, stream.ID, info)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// ... but upstream changing the bitrate does
+	info.Bitrate = 16
+	changed, err = recordSourceMetadata(db, stream.ID, info)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	var history []SourceMetadata
+	assert.NoError(t, db.Where("stream_id = ?", stream.ID).Order("id").Find(&history).Error)
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, "Fire Dispatch", history[0].Name)
+		assert.Equal(t, "Public Safety", history[0].Genre)
+		assert.Equal(t, 8, history[0].Bitrate)
+		assert.Equal(t, 16, history[1].Bitrate)
+		assert.Equal(t, info.Metadata, history[1].Metadata)
+	}
+}
+
+func TestHeartbeatsNoticeWhenAudioStops(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	var events []string
+	hb := heartbeat{
+		interval:     time.Hour,
+		save:         func(StreamHealth) {},
+		stallTimeout: 30 * time.Second,
+		onStalled:    func(since time.Time) { events = append(events, "stalled since "+since.Sub(start).String()) },
+		onResumed:    func(at time.Time) { events = append(events, "resumed at "+at.Sub(start).String()) },
+	}
+
+	for i := 0; i <= 100; i++ {
+		audio := i
+		if i > 10 && i < 60 {
+			// No audio between 10s and 60s
+			audio = 10
+		}
+		hb.onProgress(Progress{
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			OutTime:   time.Duration(audio) * time.Second,
+		})
+	}
+
+	assert.Equal(t, []string{"stalled since 10s", "resumed at 1m0s"}, events)
+}
+
+func TestHeartbeatsNoticeWhenProgressStops(t *testing.T) {
+	ctx, cancel := context.WithCancel(testContext(t))
+	defer cancel()
+	start := time.Now()
+	stalled := make(chan time.Time, 1)
+	hb := heartbeat{
+		interval:     10 * time.Millisecond,
+		save:         func(StreamHealth) {},
+		stallTimeout: 50 * time.Millisecond,
+		onStalled:    func(since time.Time) { stalled <- since },
+	}
+	go hb.watch(ctx, time.Now)
+
+	// ffmpeg reports some audio, then goes quiet without reporting progress
+	hb.onProgress(Progress{Timestamp: start, OutTime: time.Second})
+
+	select {
+	case since := <-stalled:
+		assert.Equal(t, start, since)
+	case <-time.After(5 * time.Second):
+		t.Fatal("The stall was never detected")
+	}
+}
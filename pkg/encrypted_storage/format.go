@@ -0,0 +1,218 @@
+package encrypted_storage
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+)
+
+// An encrypted blob looks like this:
+//
+//	magic        [8]byte
+//	keyIDLength  uint8
+//	keyID        [keyIDLength]byte
+//	noncePrefix  [8]byte
+//	segments     ...
+//
+// The plaintext is split into segments which are sealed individually with
+// AES-GCM, so blobs can be encrypted and decrypted without holding them in
+// memory. Each segment's nonce is the random prefix followed by a counter, and
+// the blob's key and a "last segment" flag are used as additional data. That
+// way segments can't be reordered, truncated, or moved to another blob
+// without being detected.
+var magic = [8]byte{0, 'R', 'C', 'E', 'N', 'C', 0, 1}
+
+const (
+	// segmentSize is the maximum amount of plaintext in a single segment.
+	segmentSize = 64 * 1024
+	// maxKeyIDLength is the longest key ID that can be saved in the header.
+	maxKeyIDLength   = math.MaxUint8
+	noncePrefixSize  = 8
+	segmentTagLength = 16
+)
+
+// encrypt reads plaintext from r and writes the encrypted blob to w.
+func encrypt(w io.Writer, r io.Reader, key blob.Key, keyID string, aead cipher.AEAD) error {
+	var noncePrefix [noncePrefixSize]byte
+	if _, err := rand.Read(noncePrefix[:]); err != nil {
+		return err
+	}
+
+	header := make([]byte, 0, len(magic)+1+len(keyID)+noncePrefixSize)
+	header = append(header, magic[:]...)
+	header = append(header, byte(len(keyID)))
+	header = append(header, keyID...)
+	header = append(header, noncePrefix[:]...)
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	br := bufio.NewReaderSize(r, segmentSize)
+	plaintext := make([]byte, segmentSize)
+	sealed := make([]byte, 0, segmentSize+aead.Overhead())
+
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(br, plaintext)
+		last := false
+
+		switch {
+		case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+			last = true
+		case err != nil:
+			return err
+		default:
+			// We read a full segment, so check whether there is anything
+			// after it
+			if _, err := br.Peek(1); errors.Is(err, io.EOF) {
+				last = true
+			} else if err != nil {
+				return err
+			}
+		}
+
+		if counter == math.MaxUint32 && !last {
+			return errors.New("blob is too large to encrypt")
+		}
+
+		sealed = aead.Seal(sealed[:0], segmentNonce(noncePrefix, counter), plaintext[:n], segmentAdditionalData(key, last))
+		if _, err := w.Write(sealed); err != nil {
+			return err
+		}
+
+		if last {
+			return nil
+		}
+	}
+}
+
+// readHeader checks whether a blob was encrypted, returning the ID of the key
+// that was used.
+//
+// The returned reader must be used for the rest of the blob.
+func readHeader(r io.Reader) (br *bufio.Reader, keyID string, noncePrefix [noncePrefixSize]byte, encrypted bool, err error) {
+	br = bufio.NewReaderSize(r, segmentSize+segmentTagLength)
+
+	start, err := br.Peek(len(magic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, "", noncePrefix, false, err
+	}
+	if !bytes.Equal(start, magic[:]) {
+		// Saved before encryption was enabled
+		return br, "", noncePrefix, false, nil
+	}
+	_, _ = br.Discard(len(magic))
+
+	idLength, err := br.ReadByte()
+	if err != nil {
+		return nil, "", noncePrefix, true, truncated(err)
+	}
+
+	id := make([]byte, idLength)
+	if _, err := io.ReadFull(br, id); err != nil {
+		return nil, "", noncePrefix, true, truncated(err)
+	}
+
+	if _, err := io.ReadFull(br, noncePrefix[:]); err != nil {
+		return nil, "", noncePrefix, true, truncated(err)
+	}
+
+	return br, string(id), noncePrefix, true, nil
+}
+
+// decryptingReader decrypts a blob one segment at a time.
+type decryptingReader struct {
+	r           *bufio.Reader
+	aead        cipher.AEAD
+	key         blob.Key
+	noncePrefix [noncePrefixSize]byte
+	counter     uint32
+	segment     []byte
+	plaintext   []byte
+	done        bool
+}
+
+func newDecryptingReader(r *bufio.Reader, aead cipher.AEAD, key blob.Key, noncePrefix [noncePrefixSize]byte) *decryptingReader {
+	return &decryptingReader{
+		r:           r,
+		aead:        aead,
+		key:         key,
+		noncePrefix: noncePrefix,
+		segment:     make([]byte, segmentSize+aead.Overhead()),
+	}
+}
+
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.plaintext) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.nextSegment(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.plaintext)
+	d.plaintext = d.plaintext[n:]
+	return n, nil
+}
+
+func (d *decryptingReader) nextSegment() error {
+	n, err := io.ReadFull(d.r, d.segment)
+	last := false
+
+	switch {
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		last = true
+	case err != nil:
+		return err
+	default:
+		if _, err := d.r.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	nonce := segmentNonce(d.noncePrefix, d.counter)
+	plaintext, err := d.aead.Open(d.segment[:0], nonce, d.segment[:n], segmentAdditionalData(d.key, last))
+	if err != nil {
+		return fmt.Errorf("unable to decrypt segment %d of %s: %w", d.counter, d.key, blob.ErrCorrupt)
+	}
+
+	d.plaintext = plaintext
+	d.counter++
+	d.done = last
+
+	return nil
+}
+
+func segmentNonce(prefix [noncePrefixSize]byte, counter uint32) []byte {
+	nonce := make([]byte, noncePrefixSize+4)
+	copy(nonce, prefix[:])
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	return nonce
+}
+
+func segmentAdditionalData(key blob.Key, last bool) []byte {
+	data := make([]byte, len(key)+1)
+	copy(data, key[:])
+	if last {
+		data[len(key)] = 1
+	}
+	return data
+}
+
+func truncated(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("the header is truncated: %w", blob.ErrCorrupt)
+	}
+	return err
+}
@@ -0,0 +1,102 @@
+package encrypted_storage
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// keySize is the size of an AES-256 key.
+const keySize = 32
+
+// Keyring holds the keys used to encrypt and decrypt blobs.
+//
+// Every key has an ID which is saved alongside the blobs it encrypted. New
+// blobs are always encrypted with the current key, while older keys are kept
+// around so existing blobs can still be read until they have been re-encrypted.
+type Keyring struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// ParseKeyring parses a comma-separated list of "id:base64-key" pairs, where
+// each key is 32 random bytes (e.g. from "openssl rand -base64 32").
+//
+// The first key is the current key and any others are only used for
+// decryption.
+func ParseKeyring(s string) (*Keyring, error) {
+	keyring := &Keyring{keys: make(map[string]cipher.AEAD)}
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("expected an \"id:base64-key\" pair, found %q", entry)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode key %q: %w", id, err)
+		}
+
+		if err := keyring.Add(id, key); err != nil {
+			return nil, err
+		}
+	}
+
+	if keyring.current == "" {
+		return nil, errors.New("no encryption keys provided")
+	}
+
+	return keyring, nil
+}
+
+// Add a key to the keyring. The first key to be added becomes the current key.
+func (k *Keyring) Add(id string, key []byte) error {
+	if len(id) > maxKeyIDLength {
+		return fmt.Errorf("key IDs can't be longer than %d bytes, found %q", maxKeyIDLength, id)
+	}
+	if len(key) != keySize {
+		return fmt.Errorf("key %q should be %d bytes long, found %d", id, keySize, len(key))
+	}
+	if _, exists := k.keys[id]; exists {
+		return fmt.Errorf("duplicate key ID, %q", id)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+
+	k.keys[id] = aead
+	if k.current == "" {
+		k.current = id
+	}
+
+	return nil
+}
+
+// Current gets the ID of the key used to encrypt new blobs.
+func (k *Keyring) Current() string {
+	return k.current
+}
+
+func (k *Keyring) get(id string) (cipher.AEAD, error) {
+	aead, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key, %q", id)
+	}
+
+	return aead, nil
+}
@@ -0,0 +1,130 @@
+package encrypted_storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"go.uber.org/zap"
+)
+
+// RekeyReport summarises a re-encryption run.
+type RekeyReport struct {
+	DryRun bool `json:"dry-run"`
+	// The ID of the key blobs are now encrypted with.
+	CurrentKey string `json:"current-key"`
+	// How many blobs were found in storage.
+	Checked int `json:"checked"`
+	// How many blobs were already encrypted with the current key.
+	UpToDate int `json:"up-to-date"`
+	// How many blobs were (or would be) re-encrypted, grouped by the key they
+	// were previously encrypted with. Blobs saved before encryption was
+	// enabled are listed under "plaintext".
+	Rekeyed map[string]int `json:"rekeyed"`
+	// Blobs that couldn't be re-encrypted because they are corrupt.
+	Corrupt []string `json:"corrupt"`
+}
+
+// Rekey re-encrypts every blob which isn't already encrypted with the current
+// key, including blobs that were saved before encryption was enabled.
+//
+// Once this has completed, old keys can be removed from the keyring.
+func (e *EncryptedStorage) Rekey(ctx context.Context, dryRun bool) (RekeyReport, error) {
+	report := RekeyReport{
+		DryRun:     dryRun,
+		CurrentKey: e.keyring.Current(),
+		Rekeyed:    make(map[string]int),
+	}
+
+	err := e.inner.List(ctx, func(info blob.Info) error {
+		report.Checked++
+
+		keyID, encrypted, err := e.keyID(ctx, info.Key)
+		if errors.Is(err, blob.ErrNotFound) {
+			// Deleted while we were iterating
+			return nil
+		} else if errors.Is(err, blob.ErrCorrupt) {
+			e.logger.Warn("Unable to read the header of a corrupt blob", zap.Stringer("key", info.Key), zap.Error(err))
+			report.Corrupt = append(report.Corrupt, info.Key.String())
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if encrypted && keyID == report.CurrentKey {
+			report.UpToDate++
+			return nil
+		}
+
+		previous := keyID
+		if !encrypted {
+			previous = "plaintext"
+		}
+
+		if !dryRun {
+			err := e.reencrypt(ctx, info.Key)
+			if errors.Is(err, blob.ErrCorrupt) {
+				e.logger.Warn("Unable to re-encrypt a corrupt blob", zap.Stringer("key", info.Key), zap.Error(err))
+				report.Corrupt = append(report.Corrupt, info.Key.String())
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			e.logger.Info(
+				"Re-encrypted blob",
+				zap.Stringer("key", info.Key),
+				zap.String("from", previous),
+				zap.String("to", report.CurrentKey),
+			)
+		}
+
+		report.Rekeyed[previous]++
+
+		return nil
+	})
+	if err != nil {
+		return report, fmt.Errorf("re-encryption failed: %w", err)
+	}
+
+	return report, nil
+}
+
+// keyID checks which key a blob was encrypted with.
+func (e *EncryptedStorage) keyID(ctx context.Context, key blob.Key) (string, bool, error) {
+	r, err := e.inner.Open(ctx, key)
+	if err != nil {
+		return "", false, err
+	}
+	defer r.Close()
+
+	_, keyID, _, encrypted, err := readHeader(r)
+	if err != nil {
+		return "", false, fmt.Errorf("unable to read the header for %s: %w", key, err)
+	}
+
+	return keyID, encrypted, nil
+}
+
+// reencrypt decrypts a blob and saves it again using the current key.
+func (e *EncryptedStorage) reencrypt(ctx context.Context, key blob.Key) error {
+	plaintext, err := e.Open(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer plaintext.Close()
+
+	// Note: Make sure the blob is intact before we overwrite it
+	f, actual, _, err := blob.Spool("", plaintext)
+	if err != nil {
+		return err
+	}
+	defer e.removeTemp(f)
+
+	if actual != key {
+		return fmt.Errorf("%s has been modified (actual: %s): %w", key, actual, blob.ErrCorrupt)
+	}
+
+	return e.storeAt(ctx, key, f)
+}
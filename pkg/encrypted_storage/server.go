@@ -0,0 +1,81 @@
+package encrypted_storage
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// ServeHTTP decrypts and serves blobs, making sure the caller has provided a
+// valid signature.
+//
+// The blob's key is expected to be the request path, so callers mounting the
+// EncryptedStorage under a prefix should use http.StripPrefix().
+func (e *EncryptedStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := middleware.GetLogger(r.Context())
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	key, err := blob.ParseKey(strings.TrimPrefix(r.URL.Path, "/"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	if err := e.signer.Verify(key, r.URL.Query()); err != nil {
+		logger.Warn("Rejected a blob request", zap.Stringer("key", key), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	plaintext, err := e.Open(r.Context(), key)
+	if errors.Is(err, blob.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	} else if err != nil {
+		logger.Error("Unable to open the blob", zap.Stringer("key", key), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer plaintext.Close()
+
+	// Note: Range requests need to be able to seek, so the blob gets
+	// decrypted to a temporary file. That also lets us make sure it hasn't
+	// been tampered with before sending anything.
+	f, actual, _, err := blob.Spool("", plaintext)
+	if err != nil {
+		logger.Error("Unable to decrypt the blob", zap.Stringer("key", key), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer e.removeTemp(f)
+
+	if actual != key {
+		logger.Error("Corrupt blob", zap.Stringer("key", key), zap.Stringer("actual", actual))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	mediaType, err := blob.SniffMediaType(f)
+	if err != nil {
+		logger.Error("Unable to determine the media type", zap.Stringer("key", key), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// Blobs are content-addressed, so their contents will never change
+	header := w.Header()
+	header.Set("Content-Type", mediaType)
+	header.Set("ETag", `"`+key.String()+`"`)
+	header.Set("Cache-Control", "private, max-age=31536000, immutable")
+
+	http.ServeContent(w, r, key.String(), time.Time{}, f)
+}
@@ -0,0 +1,206 @@
+// Package encrypted_storage provides a blob.Storage wrapper which encrypts
+// blobs before they are saved.
+package encrypted_storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"go.uber.org/zap"
+)
+
+// Config contains the settings used by EncryptedStorage.
+type Config struct {
+	// The keys used to encrypt and decrypt blobs.
+	Keyring *Keyring
+	// The secret used to sign links. If empty, a random secret will be
+	// generated and links will only be accepted by this instance.
+	Secret []byte
+	// The public URL that the EncryptedStorage's handler is mounted under
+	// (e.g. "https://audio.example/blobs"). Links can't be created without
+	// it.
+	BaseURL string
+}
+
+// EncryptedStorage wraps another blob.Storage, encrypting blobs with AES-GCM
+// before they are saved and decrypting them when they are read.
+//
+// Blobs keep the key derived from their plaintext, so deduplication still
+// works. Blobs that were saved before encryption was enabled are passed
+// through as-is until they are re-encrypted with Rekey().
+//
+// Links point at the EncryptedStorage's own handler (see ServeHTTP()), which
+// decrypts blobs on the fly.
+type EncryptedStorage struct {
+	logger  *zap.Logger
+	inner   blob.KeyedStorage
+	keyring *Keyring
+	signer  *blob.LinkSigner
+	baseURL *url.URL
+}
+
+// New wraps a blob.Storage so everything it saves is encrypted.
+func New(logger *zap.Logger, inner blob.Storage, cfg Config) (*EncryptedStorage, error) {
+	keyed, ok := inner.(blob.KeyedStorage)
+	if !ok {
+		return nil, fmt.Errorf("%T doesn't support encryption", inner)
+	}
+
+	if cfg.Keyring == nil {
+		return nil, errors.New("no encryption keys provided")
+	}
+
+	secret := cfg.Secret
+	if len(secret) == 0 {
+		s, err := blob.RandomSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = s
+	}
+
+	var baseURL *url.URL
+	if cfg.BaseURL != "" {
+		u, err := url.Parse(cfg.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base URL: %w", err)
+		}
+		baseURL = u
+	}
+
+	return &EncryptedStorage{
+		logger:  logger,
+		inner:   keyed,
+		keyring: cfg.Keyring,
+		signer:  blob.NewLinkSigner(secret),
+		baseURL: baseURL,
+	}, nil
+}
+
+func (e *EncryptedStorage) Close() error {
+	return e.inner.Close()
+}
+
+// Unwrap gets the blob.Storage that encrypted blobs are saved to.
+func (e *EncryptedStorage) Unwrap() blob.Storage {
+	return e.inner
+}
+
+func (e *EncryptedStorage) Link(ctx context.Context, key blob.Key, validFor time.Duration) (*url.URL, error) {
+	if e.baseURL == nil {
+		return nil, errors.New("encrypted blobs can't be linked to without a base URL")
+	}
+
+	// Note: We never hand out the inner storage's links because they would
+	// serve the ciphertext, but asking for one is a cheap way to check the
+	// blob exists.
+	if _, err := e.inner.Link(ctx, key, time.Minute); err != nil {
+		return nil, err
+	}
+
+	link := e.baseURL.JoinPath(key.String())
+
+	return e.signer.Sign(link, key, validFor), nil
+}
+
+func (e *EncryptedStorage) Store(ctx context.Context, data []byte) (blob.Key, error) {
+	return e.StoreReader(ctx, bytes.NewReader(data))
+}
+
+func (e *EncryptedStorage) StoreReader(ctx context.Context, r io.Reader) (blob.Key, error) {
+	// Note: The key is derived from the plaintext, so we need to read
+	// everything before we can start saving it.
+	f, key, _, err := blob.Spool("", r)
+	if err != nil {
+		return blob.Key{}, err
+	}
+	defer e.removeTemp(f)
+
+	if _, err := e.inner.Link(ctx, key, time.Minute); err == nil {
+		e.logger.Debug("Already exists", zap.Stringer("key", key))
+		return key, nil
+	} else if !errors.Is(err, blob.ErrNotFound) {
+		return blob.Key{}, err
+	}
+
+	if err := e.storeAt(ctx, key, f); err != nil {
+		return blob.Key{}, err
+	}
+
+	return key, nil
+}
+
+// storeAt encrypts a blob with the current key and saves it.
+func (e *EncryptedStorage) storeAt(ctx context.Context, key blob.Key, plaintext io.Reader) error {
+	keyID := e.keyring.Current()
+	aead, err := e.keyring.get(keyID)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(encrypt(pw, plaintext, key, keyID, aead))
+	}()
+	defer pr.Close()
+
+	e.logger.Debug("Saving encrypted blob", zap.Stringer("key", key), zap.String("key-id", keyID))
+
+	if err := e.inner.StoreAt(ctx, key, pr); err != nil {
+		return fmt.Errorf("unable to save %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (e *EncryptedStorage) Open(ctx context.Context, key blob.Key) (io.ReadCloser, error) {
+	r, err := e.inner.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	br, keyID, noncePrefix, encrypted, err := readHeader(r)
+	if err != nil {
+		_ = r.Close()
+		return nil, fmt.Errorf("unable to read the header for %s: %w", key, err)
+	}
+
+	if !encrypted {
+		return readCloser{Reader: br, Closer: r}, nil
+	}
+
+	aead, err := e.keyring.get(keyID)
+	if err != nil {
+		_ = r.Close()
+		return nil, fmt.Errorf("unable to decrypt %s: %w", key, err)
+	}
+
+	return readCloser{Reader: newDecryptingReader(br, aead, key, noncePrefix), Closer: r}, nil
+}
+
+func (e *EncryptedStorage) List(ctx context.Context, fn func(blob.Info) error) error {
+	return e.inner.List(ctx, fn)
+}
+
+func (e *EncryptedStorage) Delete(ctx context.Context, key blob.Key) error {
+	return e.inner.Delete(ctx, key)
+}
+
+func (e *EncryptedStorage) removeTemp(f *os.File) {
+	_ = f.Close()
+	if err := os.Remove(f.Name()); err != nil {
+		e.logger.Warn("Unable to remove the temporary file", zap.String("path", f.Name()), zap.Error(err))
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
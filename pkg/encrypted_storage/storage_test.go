@@ -0,0 +1,235 @@
+package encrypted_storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestBlobsAreEncryptedAtRest(t *testing.T) {
+	ctx := context.Background()
+	inner := mem_storage.New()
+	storage := testStorage(t, inner, testKeyring(t, "first"))
+	content := []byte("Hello, World")
+
+	key, err := storage.Store(ctx, content)
+	assert.NoError(t, err)
+	again, err := storage.StoreReader(ctx, bytes.NewReader(content))
+	assert.NoError(t, err)
+
+	assert.Equal(t, blob.KeyForBytes(content), key)
+	assert.Equal(t, key, again)
+	assert.Equal(t, []blob.Key{key}, inner.Keys())
+	raw, _ := inner.Get(key)
+	assert.NotContains(t, string(raw), string(content))
+	assert.Equal(t, string(content), readBlob(t, storage, key))
+}
+
+func TestLargeBlobsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	storage := testStorage(t, mem_storage.New(), testKeyring(t, "first"))
+
+	for _, size := range []int{0, 1, segmentSize, 3*segmentSize + 42} {
+		content := make([]byte, size)
+		_, err := rand.Read(content)
+		assert.NoError(t, err)
+
+		key, err := storage.StoreReader(ctx, bytes.NewReader(content))
+
+		assert.NoError(t, err, size)
+		assert.Equal(t, blob.KeyForBytes(content), key, size)
+		assert.Equal(t, string(content), readBlob(t, storage, key), size)
+	}
+}
+
+func TestTamperingIsDetected(t *testing.T) {
+	ctx := context.Background()
+	inner := mem_storage.New()
+	storage := testStorage(t, inner, testKeyring(t, "first"))
+	content := make([]byte, 2*segmentSize+10)
+	key, err := storage.Store(ctx, content)
+	assert.NoError(t, err)
+	raw, _ := inner.Get(key)
+
+	tampered := bytes.Clone(raw)
+	tampered[len(tampered)-1] ^= 0xFF
+	headerLength := len(raw) - 2*(segmentSize+segmentTagLength) - (10 + segmentTagLength)
+	truncated := raw[:headerLength+segmentSize+segmentTagLength]
+
+	for name, modified := range map[string][]byte{"tampered": tampered, "truncated": truncated} {
+		assert.NoError(t, inner.StoreAt(ctx, key, bytes.NewReader(modified)))
+
+		r, err := storage.Open(ctx, key)
+		assert.NoError(t, err, name)
+		_, err = io.ReadAll(r)
+
+		assert.ErrorIs(t, err, blob.ErrCorrupt, name)
+	}
+}
+
+func TestRotateKeys(t *testing.T) {
+	ctx := context.Background()
+	inner := mem_storage.New()
+	plaintextKey, err := inner.Store(ctx, []byte("saved before encryption was enabled"))
+	assert.NoError(t, err)
+	oldKeyring := testKeyring(t, "old")
+	encryptedKey, err := testStorage(t, inner, oldKeyring).Store(ctx, []byte("saved with the old key"))
+	assert.NoError(t, err)
+	keyring := testKeyring(t, "new", "old")
+	storage := testStorage(t, inner, keyring)
+
+	// Do a dry run first
+	report, err := storage.Rekey(ctx, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"plaintext": 1, "old": 1}, report.Rekeyed)
+	raw, _ := inner.Get(plaintextKey)
+	assert.Equal(t, "saved before encryption was enabled", string(raw))
+
+	// Then do it for real
+	report, err = storage.Rekey(ctx, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Checked)
+	assert.Equal(t, map[string]int{"plaintext": 1, "old": 1}, report.Rekeyed)
+	assert.Empty(t, report.Corrupt)
+	// Everything should now be readable without the old key
+	storage = testStorage(t, inner, testKeyring(t, "new"))
+	assert.Equal(t, "saved before encryption was enabled", readBlob(t, storage, plaintextKey))
+	assert.Equal(t, "saved with the old key", readBlob(t, storage, encryptedKey))
+	report, err = storage.Rekey(ctx, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.UpToDate)
+	assert.Empty(t, report.Rekeyed)
+}
+
+func TestRekeySkipsBlobsWithTruncatedHeaders(t *testing.T) {
+	ctx := context.Background()
+	inner := mem_storage.New()
+	oldStorage := testStorage(t, inner, testKeyring(t, "old"))
+	truncatedKey, err := oldStorage.Store(ctx, []byte("truncated"))
+	assert.NoError(t, err)
+	raw, _ := inner.Get(truncatedKey)
+	assert.NoError(t, inner.StoreAt(ctx, truncatedKey, bytes.NewReader(raw[:len(magic)+1])))
+	_, err = oldStorage.Store(ctx, []byte("intact"))
+	assert.NoError(t, err)
+	storage := testStorage(t, inner, testKeyring(t, "new", "old"))
+
+	report, err := storage.Rekey(ctx, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{truncatedKey.String()}, report.Corrupt)
+	assert.Equal(t, map[string]int{"old": 1}, report.Rekeyed)
+}
+
+func TestServeDecryptedBlobs(t *testing.T) {
+	ctx := context.Background()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	storage, err := New(zaptest.NewLogger(t), mem_storage.New(), Config{
+		Keyring: testKeyring(t, "first"),
+		BaseURL: server.URL + "/blobs",
+	})
+	assert.NoError(t, err)
+	mux.Handle("/blobs/", http.StripPrefix("/blobs", storage))
+	audio := "ID3\x04\x00 pretend this is an mp3"
+	key, err := storage.Store(ctx, []byte(audio))
+	assert.NoError(t, err)
+	link, err := storage.Link(ctx, key, time.Hour)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, link.String(), nil)
+	assert.NoError(t, err)
+	req.Header.Set("Range", "bytes=6-")
+	response, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusPartialContent, response.StatusCode)
+	assert.Equal(t, "audio/mpeg", response.Header.Get("Content-Type"))
+	assert.Equal(t, audio[6:], string(body))
+
+	unsigned := *link
+	unsigned.RawQuery = ""
+	response, err = http.Get(unsigned.String())
+	assert.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
+}
+
+func TestParseKeyring(t *testing.T) {
+	first := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, keySize))
+	second := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, keySize))
+
+	keyring, err := ParseKeyring("2024:" + second + ", 2023:" + first)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2024", keyring.Current())
+	assert.Len(t, keyring.keys, 2)
+
+	for _, input := range []string{
+		"",
+		"no-key",
+		"short:" + base64.StdEncoding.EncodeToString([]byte("too short")),
+		"dup:" + first + ",dup:" + second,
+		":" + first,
+	} {
+		_, err := ParseKeyring(input)
+
+		assert.Error(t, err, input)
+	}
+}
+
+func testKeyring(t *testing.T, ids ...string) *Keyring {
+	t.Helper()
+
+	var entries []string
+	for _, id := range ids {
+		// Note: Derive the key from its ID so the same ID always gets the
+		// same key
+		key := make([]byte, keySize)
+		copy(key, id)
+		entries = append(entries, id+":"+base64.StdEncoding.EncodeToString(key))
+	}
+
+	keyring, err := ParseKeyring(strings.Join(entries, ","))
+	assert.NoError(t, err)
+
+	return keyring
+}
+
+func testStorage(t *testing.T, inner blob.Storage, keyring *Keyring) *EncryptedStorage {
+	t.Helper()
+
+	storage, err := New(zaptest.NewLogger(t), inner, Config{Keyring: keyring})
+	assert.NoError(t, err)
+
+	return storage
+}
+
+func readBlob(t *testing.T, storage blob.Storage, key blob.Key) string {
+	t.Helper()
+
+	r, err := storage.Open(context.Background(), key)
+	assert.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+
+	return string(data)
+}
@@ -0,0 +1,177 @@
+package radiochatter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// DefaultGCGracePeriod is how old an unreferenced blob needs to be before the
+// garbage collector will delete it.
+//
+// The archiver saves a blob before creating the database row that references
+// it, so we need to leave recent blobs alone.
+const DefaultGCGracePeriod = 24 * time.Hour
+
+// GarbageCollectionReport summarises a garbage collection run.
+type GarbageCollectionReport struct {
+	DryRun bool `json:"dry-run"`
+	// How many blobs were found in storage.
+	Scanned int `json:"scanned"`
+	// How many blobs are still referenced by the database.
+	Referenced int `json:"referenced"`
+	// How many unreferenced blobs were skipped because they are still within
+	// the grace period.
+	TooRecent int `json:"too-recent"`
+	// The blobs that are no longer referenced.
+	Unreferenced []UnreferencedBlob `json:"unreferenced"`
+	// The number of bytes that were (or would be) freed.
+	BytesFreed int64 `json:"bytes-freed"`
+}
+
+// UnreferencedBlob is a blob that isn't referenced by the database.
+type UnreferencedBlob struct {
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"last-modified"`
+	Deleted      bool      `json:"deleted"`
+}
+
+// CollectGarbage deletes any blobs which are older than the grace period and
+// not referenced by a Chunk or Transmission.
+//
+// When dryRun is set, nothing will be deleted and the report says which blobs
+// would have been deleted.
+func CollectGarbage(
+	ctx context.Context,
+	logger *zap.Logger,
+	db *gorm.DB,
+	storage blob.Storage,
+	gracePeriod time.Duration,
+	dryRun bool,
+) (GarbageCollectionReport, error) {
+	return collectGarbage(ctx, logger, db, storage, gracePeriod, dryRun, time.Now)
+}
+
+func collectGarbage(
+	ctx context.Context,
+	logger *zap.Logger,
+	db *gorm.DB,
+	storage blob.Storage,
+	gracePeriod time.Duration,
+	dryRun bool,
+	now func() time.Time,
+) (GarbageCollectionReport, error) {
+	report := GarbageCollectionReport{DryRun: dryRun}
+
+	// Note: We need to read the cutoff before loading the referenced keys.
+	// Otherwise a blob could be saved and referenced after we load the keys,
+	// and still be old enough to delete.
+	cutoff := now().Add(-gracePeriod)
+
+	referenced, err := referencedBlobs(db.WithContext(ctx))
+	if err != nil {
+		return report, err
+	}
+
+	logger.Debug("Loaded referenced blobs", zap.Int("count", len(referenced)))
+
+	err = storage.List(ctx, func(info blob.Info) error {
+		report.Scanned++
+
+		if _, ok := referenced[info.Key.String()]; ok {
+			report.Referenced++
+			return nil
+		}
+
+		if info.LastModified.After(cutoff) {
+			report.TooRecent++
+			return nil
+		}
+
+		unreferenced := UnreferencedBlob{
+			Key:          info.Key.String(),
+			Size:         info.Size,
+			LastModified: info.LastModified,
+		}
+
+		if !dryRun {
+			err := storage.Delete(ctx, info.Key)
+			if err != nil && !errors.Is(err, blob.ErrNotFound) {
+				return fmt.Errorf("unable to delete %s: %w", info.Key, err)
+			}
+			unreferenced.Deleted = true
+			logger.Info(
+				"Deleted unreferenced blob",
+				zap.Stringer("key", info.Key),
+				zap.Int64("bytes", info.Size),
+			)
+		}
+
+		report.Unreferenced = append(report.Unreferenced, unreferenced)
+		report.BytesFreed += info.Size
+
+		return nil
+	})
+	if err != nil {
+		return report, fmt.Errorf("garbage collection failed: %w", err)
+	}
+
+	return report, nil
+}
+
+// referencedBlobs gets the set of blob keys referenced by the database.
+//
+// Rows belonging to a stream that has been deleted don't count as references,
+// and neither do rows whose audio has been expired by the retention job.
+func referencedBlobs(db *gorm.DB) (map[string]struct{}, error) {
+	referenced := make(map[string]struct{})
+
+	var chunkKeys []string
+	err := db.Model(&Chunk{}).
+		Joins("JOIN streams ON streams.id = chunks.stream_id AND streams.deleted_at IS NULL").
+		Where("chunks.audio_expired_at IS NULL").
+		Pluck("chunks.sha256", &chunkKeys).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the chunk keys: %w", err)
+	}
+
+	var rawChunkKeys []string
+	err = db.Model(&Chunk{}).
+		Joins("JOIN streams ON streams.id = chunks.stream_id AND streams.deleted_at IS NULL").
+		Where("chunks.audio_expired_at IS NULL AND chunks.raw_sha256 <> ''").
+		Pluck("chunks.raw_sha256", &rawChunkKeys).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the raw chunk keys: %w", err)
+	}
+
+	var transmissionKeys []string
+	err = db.Model(&Transmission{}).
+		Joins("JOIN chunks ON chunks.id = transmissions.chunk_id AND chunks.deleted_at IS NULL").
+		Joins("JOIN streams ON streams.id = chunks.stream_id AND streams.deleted_at IS NULL").
+		Where("transmissions.audio_expired_at IS NULL").
+		Pluck("transmissions.sha256", &transmissionKeys).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the transmission keys: %w", err)
+	}
+
+	for _, key := range chunkKeys {
+		referenced[key] = struct{}{}
+	}
+	for _, key := range rawChunkKeys {
+		referenced[key] = struct{}{}
+	}
+	for _, key := range transmissionKeys {
+		referenced[key] = struct{}{}
+	}
+
+	return referenced, nil
+}
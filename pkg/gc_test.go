@@ -0,0 +1,90 @@
+package radiochatter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestGarbageCollection(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	storage := mem_storage.New()
+	stream := Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, db.Save(&stream).Error)
+	chunkKey := storeString(ctx, t, storage, "chunk")
+	rawKey := storeString(ctx, t, storage, "raw chunk")
+	chunk := Chunk{StreamID: stream.ID, Sha256: chunkKey.String(), RawSha256: rawKey.String()}
+	assert.NoError(t, db.Save(&chunk).Error)
+	transmissionKey := storeString(ctx, t, storage, "transmission")
+	assert.NoError(t, db.Save(&Transmission{ChunkID: chunk.ID, Sha256: transmissionKey.String()}).Error)
+	orphanKey := storeString(ctx, t, storage, "orphan")
+	deletedStream := Stream{DisplayName: "Deleted", Url: "..."}
+	assert.NoError(t, db.Save(&deletedStream).Error)
+	deletedKey := storeString(ctx, t, storage, "deleted")
+	assert.NoError(t, db.Save(&Chunk{StreamID: deletedStream.ID, Sha256: deletedKey.String()}).Error)
+	assert.NoError(t, db.Delete(&deletedStream).Error)
+	later := func() time.Time { return time.Now().Add(2 * time.Hour) }
+
+	// Do a dry run first
+	report, err := collectGarbage(ctx, logger, db, storage, time.Hour, true, later)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, report.Scanned)
+	assert.Equal(t, 3, report.Referenced)
+	assert.Len(t, report.Unreferenced, 2)
+	assert.Equal(t, int64(len("orphan")+len("deleted")), report.BytesFreed)
+	assertBlobExists(ctx, t, storage, orphanKey)
+
+	// Then do it for real
+	report, err = collectGarbage(ctx, logger, db, storage, time.Hour, false, later)
+
+	assert.NoError(t, err)
+	assert.Len(t, report.Unreferenced, 2)
+	for _, unreferenced := range report.Unreferenced {
+		assert.True(t, unreferenced.Deleted)
+	}
+	assertBlobExists(ctx, t, storage, chunkKey)
+	assertBlobExists(ctx, t, storage, rawKey)
+	assertBlobExists(ctx, t, storage, transmissionKey)
+	_, err = storage.Open(ctx, orphanKey)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	_, err = storage.Open(ctx, deletedKey)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestGarbageCollectionRespectsGracePeriod(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	storage := mem_storage.New()
+	orphanKey := storeString(ctx, t, storage, "orphan")
+
+	report, err := collectGarbage(ctx, logger, db, storage, time.Hour, false, time.Now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.TooRecent)
+	assert.Empty(t, report.Unreferenced)
+	assertBlobExists(ctx, t, storage, orphanKey)
+}
+
+func storeString(ctx context.Context, t *testing.T, storage blob.Storage, content string) blob.Key {
+	t.Helper()
+	key, err := storage.Store(ctx, []byte(content))
+	assert.NoError(t, err)
+	return key
+}
+
+func assertBlobExists(ctx context.Context, t *testing.T, storage blob.Storage, key blob.Key) {
+	t.Helper()
+	r, err := storage.Open(ctx, key)
+	if assert.NoError(t, err) {
+		_ = r.Close()
+	}
+}
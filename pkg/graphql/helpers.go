@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -76,34 +77,340 @@ func typeOf[T any]() reflect.Type {
 }
 
 func streamToGraphQL(t radiochatter.Stream) model.Stream {
+	opts := t.PreprocessOptions()
+	policy := t.RestartPolicy
+	if policy == "" {
+		policy = radiochatter.RestartAlways
+	}
+
 	return model.Stream{
-		ID:          modelId(t),
-		CreatedAt:   t.CreatedAt.UTC(),
-		UpdatedAt:   t.UpdatedAt.UTC(),
-		DisplayName: t.DisplayName,
-		URL:         t.Url,
+		ID:                    modelId(t),
+		CreatedAt:             t.CreatedAt.UTC(),
+		UpdatedAt:             t.UpdatedAt.UTC(),
+		DisplayName:           t.DisplayName,
+		URL:                   t.Url,
+		ChunkRetention:        durationOrNil(t.ChunkRetention),
+		TransmissionRetention: durationOrNil(t.TransmissionRetention),
+		ChunkLength:           durationOrNil(t.ChunkLength),
+		ChunkCodec:            string(opts.ChunkCodec),
+		TransmissionCodec:     string(opts.TransmissionCodec),
+		AudioFilter:           string(opts.AudioFilter),
+		KeepRawChunks:         t.KeepRawChunks,
+		SilenceDetector:       string(opts.SilenceDetector),
+		NoiseThreshold:        opts.NoiseThreshold,
+		MinSilence:            opts.MinSilence.Seconds(),
+		Padding:               opts.Padding.Seconds(),
+		RestartPolicy:         string(policy),
+		MaxFailures:           t.MaxFailures,
+	}
+}
+
+func streamStatusToGraphQL(s radiochatter.StreamStatus) model.StreamStatus {
+	status := model.StreamStatus{
+		State:     string(s.State),
+		Failures:  s.Failures,
+		RetryAt:   utcOrNil(s.RetryAt),
+		UpdatedAt: s.UpdatedAt.UTC(),
+	}
+
+	if s.LastError != "" {
+		status.LastError = &s.LastError
+	}
+
+	return status
+}
+
+func streamHealthToGraphQL(h radiochatter.StreamHealth) model.StreamHealth {
+	return model.StreamHealth{
+		LastAudioReceived: utcOrNil(h.LastAudioAt),
+		AudioTime:         h.AudioTime.Seconds(),
+		Bitrate:           h.Bitrate,
+		Speed:             h.Speed,
+		Drift:             h.Drift.Seconds(),
+		Lagging:           h.Lagging(),
+		UpdatedAt:         h.UpdatedAt.UTC(),
+	}
+}
+
+func sourceMetadataToGraphQL(m radiochatter.SourceMetadata) model.SourceMetadata {
+	value := model.SourceMetadata{
+		ID:         modelId(m),
+		DetectedAt: m.CreatedAt.UTC(),
+		Format:     m.Format,
+		Codec:      m.Codec,
+		SampleRate: m.SampleRate,
+		Channels:   m.Channels,
+		Bitrate:    m.Bitrate,
+		Metadata:   []model.MetadataEntry{},
+	}
+
+	if m.Name != "" {
+		value.Name = &m.Name
+	}
+	if m.Genre != "" {
+		value.Genre = &m.Genre
+	}
+
+	keys := make([]string, 0, len(m.Metadata))
+	for key := range m.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value.Metadata = append(value.Metadata, model.MetadataEntry{Key: key, Value: m.Metadata[key]})
+	}
+
+	return value
+}
+
+func streamOutageToGraphQL(o radiochatter.StreamOutage) model.StreamOutage {
+	return model.StreamOutage{
+		ID:     modelId(o),
+		Start:  o.StartedAt.UTC(),
+		End:    utcOrNil(o.EndedAt),
+		Reason: o.Reason,
+	}
+}
+
+// outagesBetween finds a stream's outages which overlap a period of time.
+func outagesBetween(db *gorm.DB, streamID uint, from, to time.Time) ([]radiochatter.StreamOutage, error) {
+	var outages []radiochatter.StreamOutage
+
+	err := db.
+		Where("stream_id = ? AND started_at < ? AND (ended_at IS NULL OR ended_at > ?)", streamID, to, from).
+		Order("started_at").
+		Find(&outages).
+		Error
+
+	return outages, err
+}
+
+// statsPeriod fills in the defaults for a period of time, going from when the
+// stream was created until now.
+func statsPeriod(stream *model.Stream, from, to *time.Time, now time.Time) (time.Time, time.Time) {
+	start := stream.CreatedAt
+	if from != nil {
+		start = *from
+	}
+
+	end := now
+	if to != nil {
+		end = *to
+	}
+
+	return start.UTC(), end.UTC()
+}
+
+// durationOrNil converts an optional duration to seconds, where zero becomes
+// null.
+func durationOrNil(d time.Duration) *float64 {
+	if d <= 0 {
+		return nil
+	}
+
+	seconds := d.Seconds()
+	return &seconds
+}
+
+// setRetention updates a stream's retention periods. A nil value is left
+// unchanged and zero means "keep forever".
+func setRetention(stream *radiochatter.Stream, chunks, transmissions *float64) error {
+	for _, field := range []struct {
+		seconds *float64
+		dest    *time.Duration
+	}{
+		{chunks, &stream.ChunkRetention},
+		{transmissions, &stream.TransmissionRetention},
+	} {
+		if field.seconds == nil {
+			continue
+		} else if *field.seconds < 0 {
+			return fmt.Errorf("retention periods can't be negative, found %v", *field.seconds)
+		}
+
+		*field.dest = secondsToDuration(*field.seconds)
+	}
+
+	return nil
+}
+
+// setPreprocessOptions updates a stream's chunk length and silence detection
+// settings, leaving nil values unchanged. A chunk length of zero means the
+// deployment's default will be used.
+func setPreprocessOptions(stream *radiochatter.Stream, chunkLength, noiseThreshold, minSilence, padding *float64) error {
+	opts := stream.PreprocessOptions()
+	if chunkLength != nil && *chunkLength != 0 {
+		opts.ChunkLength = secondsToDuration(*chunkLength)
+	}
+	if noiseThreshold != nil {
+		opts.NoiseThreshold = *noiseThreshold
+	}
+	if minSilence != nil {
+		opts.MinSilence = secondsToDuration(*minSilence)
+	}
+	if padding != nil {
+		opts.Padding = secondsToDuration(*padding)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	if chunkLength != nil {
+		stream.ChunkLength = secondsToDuration(*chunkLength)
 	}
+	if noiseThreshold != nil {
+		stream.NoiseThreshold = opts.NoiseThreshold
+	}
+	if minSilence != nil {
+		stream.MinSilence = opts.MinSilence
+	}
+	if padding != nil {
+		stream.Padding = &opts.Padding
+	}
+
+	return nil
+}
+
+// setSilenceDetector updates a stream's silence detector, leaving nil values
+// unchanged.
+func setSilenceDetector(stream *radiochatter.Stream, detector *string) error {
+	if detector == nil {
+		return nil
+	}
+
+	parsed, err := radiochatter.ParseSilenceDetector(*detector)
+	if err != nil {
+		return err
+	}
+	stream.SilenceDetector = parsed
+
+	return nil
+}
+
+// setCodecs updates the formats a stream's chunks and transmissions are saved
+// in, leaving nil values unchanged. An empty transmission codec means the
+// chunk codec is used.
+func setCodecs(stream *radiochatter.Stream, chunkCodec, transmissionCodec *string) error {
+	if chunkCodec != nil {
+		parsed, err := radiochatter.ParseAudioCodec(*chunkCodec)
+		if err != nil {
+			return err
+		}
+		stream.ChunkCodec = parsed
+	}
+	if transmissionCodec != nil {
+		stream.TransmissionCodec = ""
+		if *transmissionCodec != "" {
+			parsed, err := radiochatter.ParseAudioCodec(*transmissionCodec)
+			if err != nil {
+				return err
+			}
+			stream.TransmissionCodec = parsed
+		}
+	}
+
+	return nil
+}
+
+// setAudioFilter updates a stream's audio filter and whether it keeps raw
+// chunks, leaving nil values unchanged.
+func setAudioFilter(stream *radiochatter.Stream, filter *string, keepRawChunks *bool) error {
+	if filter != nil {
+		parsed, err := radiochatter.ParseAudioFilter(*filter)
+		if err != nil {
+			return err
+		}
+		stream.AudioFilter = parsed
+	}
+	if keepRawChunks != nil {
+		stream.KeepRawChunks = *keepRawChunks
+	}
+
+	return nil
+}
+
+// setRestartPolicy updates a stream's restart policy, leaving nil values
+// unchanged.
+func setRestartPolicy(stream *radiochatter.Stream, policy *string, maxFailures *int) error {
+	if policy != nil {
+		parsed, err := radiochatter.ParseRestartPolicy(*policy)
+		if err != nil {
+			return err
+		}
+		stream.RestartPolicy = parsed
+	}
+
+	if maxFailures != nil {
+		if *maxFailures < 0 {
+			return fmt.Errorf("the maximum number of failures can't be negative, found %d", *maxFailures)
+		}
+		stream.MaxFailures = *maxFailures
+	}
+
+	return nil
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
 }
 
 func chunkToGraphQL(t radiochatter.Chunk) model.Chunk {
-	return model.Chunk{
-		ID:        modelId(t),
-		CreatedAt: t.CreatedAt.UTC(),
-		UpdatedAt: t.UpdatedAt.UTC(),
-		Timestamp: t.TimeStamp,
-		Sha256:    t.Sha256,
+	codec, mediaType := audioFormat(t.Codec, t.MediaType)
+	chunk := model.Chunk{
+		ID:             modelId(t),
+		CreatedAt:      t.CreatedAt.UTC(),
+		UpdatedAt:      t.UpdatedAt.UTC(),
+		Timestamp:      t.TimeStamp,
+		Length:         durationOrNil(t.Length),
+		Codec:          codec,
+		MediaType:      mediaType,
+		Sha256:         t.Sha256,
+		AudioExpiredAt: utcOrNil(t.AudioExpiredAt),
+	}
+
+	if t.RawSha256 != "" {
+		chunk.RawSha256 = &t.RawSha256
 	}
+
+	return chunk
 }
 
 func transmissionToGraphQL(t radiochatter.Transmission) model.Transmission {
+	codec, mediaType := audioFormat(t.Codec, t.MediaType)
 	return model.Transmission{
-		ID:        modelId(t),
-		CreatedAt: t.CreatedAt.UTC(),
-		UpdatedAt: t.UpdatedAt.UTC(),
-		Timestamp: t.TimeStamp,
-		Length:    t.Length.Seconds(),
-		Sha256:    t.Sha256,
+		ID:             modelId(t),
+		CreatedAt:      t.CreatedAt.UTC(),
+		UpdatedAt:      t.UpdatedAt.UTC(),
+		Timestamp:      t.TimeStamp,
+		Length:         t.Length.Seconds(),
+		Codec:          codec,
+		MediaType:      mediaType,
+		Sha256:         t.Sha256,
+		AudioExpiredAt: utcOrNil(t.AudioExpiredAt),
+	}
+}
+
+// audioFormat gets the codec and media type for a chunk or transmission.
+// Rows saved before these were recorded are always mp3.
+func audioFormat(codec radiochatter.AudioCodec, mediaType string) (string, string) {
+	if codec == "" {
+		codec = radiochatter.CodecMP3
 	}
+	if mediaType == "" {
+		mediaType = codec.MediaType()
+	}
+
+	return string(codec), mediaType
+}
+
+func utcOrNil(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	utc := t.UTC()
+	return &utc
 }
 
 func transcriptionToGraphQL(t radiochatter.Transcription) model.Transcription {
@@ -154,7 +461,7 @@ func (p poller[Model, Generated]) begin(ctx context.Context) <-chan *Generated {
 
 	interval := p.interval
 	if interval == 0 {
-		interval = radiochatter.ChunkLength
+		interval = radiochatter.DefaultPollInterval
 	}
 
 	go func() {
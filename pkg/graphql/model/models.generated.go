@@ -23,10 +23,29 @@ type Chunk struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 	// When the chunk was first broadcast.
 	Timestamp time.Time `json:"timestamp"`
+	// How long is the chunk, in seconds? This is null for chunks recorded before
+	// lengths were tracked.
+	Length *float64 `json:"length,omitempty"`
+	// The format the chunk's audio is saved in (e.g. "mp3").
+	Codec string `json:"codec"`
+	// The chunk's MIME type (e.g. "audio/mpeg").
+	MediaType string `json:"mediaType"`
 	// A SHA-256 checksum of the chunk's audio file.
 	Sha256 string `json:"sha256"`
 	// Where the chunk's audio file can be downloaded from.
+	//
+	// This will be null if the audio is no longer available (e.g. because it was
+	// deleted by the stream's retention policy).
 	DownloadURL *string `json:"downloadUrl,omitempty"`
+	// A SHA-256 checksum of the chunk's audio before it was cleaned up by the
+	// stream's audio filter. This is null unless the stream keeps raw chunks.
+	RawSha256 *string `json:"rawSha256,omitempty"`
+	// Where the chunk's raw audio can be downloaded from, if it was kept and is
+	// still available.
+	RawDownloadURL *string `json:"rawDownloadUrl,omitempty"`
+	// When the chunk's audio was deleted by the stream's retention policy, if it
+	// has been. The chunk's transmissions are kept.
+	AudioExpiredAt *time.Time `json:"audioExpiredAt,omitempty"`
 	// Iterate over the radio messages detected in the chunk.
 	Transmissions *TransmissionsConnection `json:"transmissions"`
 	// The stream this chunk belongs to.
@@ -49,6 +68,11 @@ type ChunksConnection struct {
 	PageInfo *PageInfo `json:"pageInfo"`
 }
 
+type MetadataEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type Mutation struct {
 }
 
@@ -68,6 +92,56 @@ type Query struct {
 type RegisterStreamVariables struct {
 	DisplayName string `json:"displayName"`
 	URL         string `json:"url"`
+	// How long to keep raw chunks, in seconds. Omit to keep them forever.
+	ChunkRetention *float64 `json:"chunkRetention,omitempty"`
+	// How long to keep transmissions, in seconds. Omit to keep them forever.
+	TransmissionRetention *float64 `json:"transmissionRetention,omitempty"`
+	// How long each chunk should be, in seconds. Omit to use the default.
+	ChunkLength *float64 `json:"chunkLength,omitempty"`
+	// The format chunks are saved in. Defaults to "mp3".
+	ChunkCodec *string `json:"chunkCodec,omitempty"`
+	// The format transmissions are saved in. Defaults to the chunk codec.
+	TransmissionCodec *string `json:"transmissionCodec,omitempty"`
+	// How to clean up the audio. Defaults to "none".
+	AudioFilter *string `json:"audioFilter,omitempty"`
+	// Keep the raw audio for each chunk. Defaults to false.
+	KeepRawChunks *bool `json:"keepRawChunks,omitempty"`
+	// How to detect silence. Defaults to "silencedetect".
+	SilenceDetector *string `json:"silenceDetector,omitempty"`
+	// The silence threshold, in dB. Omit to use the default.
+	NoiseThreshold *float64 `json:"noiseThreshold,omitempty"`
+	// The minimum silence duration, in seconds. Omit to use the default.
+	MinSilence *float64 `json:"minSilence,omitempty"`
+	// The padding around each transmission, in seconds. Omit to use the default.
+	Padding *float64 `json:"padding,omitempty"`
+	// When to restart the stream after it stops. Defaults to "always".
+	RestartPolicy *string `json:"restartPolicy,omitempty"`
+	// Give up after this many consecutive failures. Omit to never give up.
+	MaxFailures *int `json:"maxFailures,omitempty"`
+}
+
+// Information about a stream's source, taken from the header (including ICY
+// metadata) that ffmpeg prints when it connects.
+type SourceMetadata struct {
+	ID string `json:"id"`
+	// When this metadata was first seen.
+	DetectedAt time.Time `json:"detectedAt"`
+	// The stream's name, from the icy-name header.
+	Name *string `json:"name,omitempty"`
+	// The stream's genre, from the icy-genre header.
+	Genre *string `json:"genre,omitempty"`
+	// The container format (e.g. "mp3").
+	Format string `json:"format"`
+	// The audio codec (e.g. "mp3").
+	Codec string `json:"codec"`
+	// The audio's sample rate, in Hz.
+	SampleRate int `json:"sampleRate"`
+	// The channel layout (e.g. "mono").
+	Channels string `json:"channels"`
+	// The bitrate, in kb/s.
+	Bitrate int `json:"bitrate"`
+	// All the metadata attached to the input.
+	Metadata []MetadataEntry `json:"metadata"`
 }
 
 // A stream to monitor and extract transmissions from.
@@ -82,6 +156,59 @@ type Stream struct {
 	// This is typically a URL, but can technically be anything ffmpeg allows as an
 	// input.
 	URL string `json:"url"`
+	// How long to keep the audio for raw chunks, in seconds. Null means forever.
+	ChunkRetention *float64 `json:"chunkRetention,omitempty"`
+	// How long to keep the audio for transmissions, in seconds. Null means forever.
+	TransmissionRetention *float64 `json:"transmissionRetention,omitempty"`
+	// How long each chunk of audio is, in seconds. Null means the deployment's
+	// default is used.
+	ChunkLength *float64 `json:"chunkLength,omitempty"`
+	// The format chunks are saved in. One of "mp3", "opus" (in an Ogg container),
+	// or "wav".
+	ChunkCodec string `json:"chunkCodec"`
+	// The format transmissions are saved in. This is the same as chunkCodec unless
+	// it has been overridden.
+	TransmissionCodec string `json:"transmissionCodec"`
+	// The preset used to clean up the audio (removing hum and hiss, evening out
+	// the volume, etc.) before looking for transmissions. One of "none",
+	// "light", "voice", or "noisy".
+	AudioFilter string `json:"audioFilter"`
+	// Whether the raw audio for each chunk is kept alongside the cleaned up
+	// version.
+	KeepRawChunks bool `json:"keepRawChunks"`
+	// How silence between transmissions is detected. Either "silencedetect"
+	// (ffmpeg's volume-based filter) or "vad" (voice activity detection).
+	SilenceDetector string `json:"silenceDetector"`
+	// Audio quieter than this (in dB) is treated as silence when splitting the
+	// stream into transmissions.
+	NoiseThreshold float64 `json:"noiseThreshold"`
+	// How long the audio needs to be quiet (in seconds) before it counts as
+	// silence.
+	MinSilence float64 `json:"minSilence"`
+	// How much audio (in seconds) to keep either side of a transmission so it
+	// doesn't sound like it has been cut off.
+	Padding float64 `json:"padding"`
+	// When the downloader should restart the stream after it stops. Either
+	// "always" or "on-failure".
+	RestartPolicy string `json:"restartPolicy"`
+	// The downloader gives up on the stream after this many consecutive failures.
+	// Zero means it never gives up.
+	MaxFailures int `json:"maxFailures"`
+	// What the downloader is currently doing with this stream, if it has started.
+	Status *StreamStatus `json:"status,omitempty"`
+	// How well the stream is being recorded, if the downloader has reported on it.
+	Health *StreamHealth `json:"health,omitempty"`
+	// What the stream's server said about itself the last time the downloader
+	// connected, if it has connected.
+	Source *SourceMetadata `json:"source,omitempty"`
+	// Every distinct set of source metadata seen for this stream, oldest first.
+	SourceHistory []SourceMetadata `json:"sourceHistory"`
+	// Times when the stream wasn't being recorded, oldest first. Only outages that
+	// overlap the period between from and to are included.
+	Outages []StreamOutage `json:"outages"`
+	// Statistics for the period between from and to. The period defaults to
+	// everything from when the stream was registered until now.
+	Stats *StreamStats `json:"stats"`
 	// Iterate over the raw chunks of audio downloaded for this stream.
 	Chunks *ChunksConnection `json:"chunks"`
 	// Iterate over the radio messages detected in the stream.
@@ -99,6 +226,65 @@ func (this Stream) GetCreatedAt() time.Time { return this.CreatedAt }
 // When the item was last updated.
 func (this Stream) GetUpdatedAt() time.Time { return this.UpdatedAt }
 
+// A heartbeat from the downloader, based on ffmpeg's progress reports.
+type StreamHealth struct {
+	// When ffmpeg last reported receiving more audio.
+	LastAudioReceived *time.Time `json:"lastAudioReceived,omitempty"`
+	// How much audio (in seconds) has been processed since ffmpeg was last
+	// (re)started.
+	AudioTime float64 `json:"audioTime"`
+	// The output bitrate, in kbit/s.
+	Bitrate float64 `json:"bitrate"`
+	// How fast the audio is being processed, relative to real time.
+	Speed float64 `json:"speed"`
+	// How far (in seconds) the processed audio lags behind the wall clock.
+	Drift float64 `json:"drift"`
+	// Whether the audio is being processed slower than real time.
+	Lagging bool `json:"lagging"`
+	// When the heartbeat was sent.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// A period where a stream wasn't being recorded, as opposed to nobody talking.
+type StreamOutage struct {
+	ID string `json:"id"`
+	// When recording stopped.
+	Start time.Time `json:"start"`
+	// When recording resumed. Null if the outage is still going.
+	End *time.Time `json:"end,omitempty"`
+	// Why recording stopped.
+	Reason string `json:"reason"`
+}
+
+type StreamStats struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// How many chunks were recorded.
+	Chunks int `json:"chunks"`
+	// How many transmissions were detected.
+	Transmissions int `json:"transmissions"`
+	// How many outages overlapped this period.
+	Outages int `json:"outages"`
+	// How long (in seconds) the stream wasn't being recorded.
+	Downtime float64 `json:"downtime"`
+	// The percentage of the period where the stream was being recorded.
+	Coverage float64 `json:"coverage"`
+}
+
+// The latest status reported by a stream's downloader.
+type StreamStatus struct {
+	// One of "running", "backing-off", "failed" or "stopped".
+	State string `json:"state"`
+	// How many times in a row the stream has failed.
+	Failures int `json:"failures"`
+	// The most recent error, if there was one.
+	LastError *string `json:"lastError,omitempty"`
+	// When the downloader will try again, if it is backing off.
+	RetryAt *time.Time `json:"retryAt,omitempty"`
+	// When the status was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 type StreamsConnection struct {
 	Edges    []Stream  `json:"edges,omitempty"`
 	PageInfo *PageInfo `json:"pageInfo"`
@@ -136,11 +322,21 @@ type Transmission struct {
 	Timestamp time.Time `json:"timestamp"`
 	// How long is the transmission, in seconds?
 	Length float64 `json:"length"`
+	// The format the transmission's audio is saved in (e.g. "mp3").
+	Codec string `json:"codec"`
+	// The transmission's MIME type (e.g. "audio/mpeg").
+	MediaType string `json:"mediaType"`
 	// A SHA-256 checksum of the chunk's audio file.
 	Sha256 string `json:"sha256"`
 	// Where the chunk's audio file can be downloaded from.
-	DownloadURL   *string        `json:"downloadUrl,omitempty"`
-	Transcription *Transcription `json:"transcription,omitempty"`
+	//
+	// This will be null if the audio is no longer available (e.g. because it was
+	// deleted by the stream's retention policy).
+	DownloadURL *string `json:"downloadUrl,omitempty"`
+	// When the transmission's audio was deleted by the stream's retention policy,
+	// if it has been.
+	AudioExpiredAt *time.Time     `json:"audioExpiredAt,omitempty"`
+	Transcription  *Transcription `json:"transcription,omitempty"`
 	// The chunk this transmission belongs to.
 	Chunk *Chunk `json:"chunk"`
 }
@@ -160,3 +356,37 @@ type TransmissionsConnection struct {
 	Edges    []Transmission `json:"edges,omitempty"`
 	PageInfo *PageInfo      `json:"pageInfo"`
 }
+
+// Changes to make to a stream. Fields that are omitted are left unchanged.
+type UpdateStreamVariables struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	URL         *string `json:"url,omitempty"`
+	// How long to keep raw chunks, in seconds. Use 0 to keep them forever.
+	ChunkRetention *float64 `json:"chunkRetention,omitempty"`
+	// How long to keep transmissions, in seconds. Use 0 to keep them forever.
+	TransmissionRetention *float64 `json:"transmissionRetention,omitempty"`
+	// How long each chunk should be, in seconds. Use 0 to go back to the
+	// deployment's default.
+	ChunkLength *float64 `json:"chunkLength,omitempty"`
+	// The format chunks are saved in, either "mp3", "opus", or "wav".
+	ChunkCodec *string `json:"chunkCodec,omitempty"`
+	// The format transmissions are saved in, either "mp3", "opus", or "wav". Use
+	// an empty string to go back to using the chunk codec.
+	TransmissionCodec *string `json:"transmissionCodec,omitempty"`
+	// How to clean up the audio, either "none", "light", "voice", or "noisy".
+	AudioFilter *string `json:"audioFilter,omitempty"`
+	// Whether to keep the raw audio for each chunk.
+	KeepRawChunks *bool `json:"keepRawChunks,omitempty"`
+	// How to detect silence, either "silencedetect" or "vad".
+	SilenceDetector *string `json:"silenceDetector,omitempty"`
+	// The silence threshold, in dB.
+	NoiseThreshold *float64 `json:"noiseThreshold,omitempty"`
+	// The minimum silence duration, in seconds.
+	MinSilence *float64 `json:"minSilence,omitempty"`
+	// The padding around each transmission, in seconds.
+	Padding *float64 `json:"padding,omitempty"`
+	// When to restart the stream after it stops.
+	RestartPolicy *string `json:"restartPolicy,omitempty"`
+	// Give up after this many consecutive failures. Use 0 to never give up.
+	MaxFailures *int `json:"maxFailures,omitempty"`
+}
@@ -9,8 +9,9 @@ import (
 	"time"
 
 	radiochatter "github.com/Michael-F-Bryan/radio-chatter/pkg"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/graphql/model"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
 	"github.com/Michael-F-Bryan/radio-chatter/pkg/middleware"
-	"github.com/Michael-F-Bryan/radio-chatter/pkg/on_disk_storage"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
 	"gorm.io/driver/sqlite"
@@ -19,11 +20,8 @@ import (
 )
 
 func TestGetStreamByID(t *testing.T) {
-	logger := zaptest.NewLogger(t)
 	ctx := testContext(t)
-	storage, err := on_disk_storage.New(logger, t.TempDir())
-	assert.NoError(t, err)
-	defer storage.Close()
+	storage := mem_storage.New()
 	resolver := Resolver{
 		DB:      testDatabase(ctx, t),
 		Storage: storage,
@@ -39,11 +37,8 @@ func TestGetStreamByID(t *testing.T) {
 }
 
 func TestGetChunkByID(t *testing.T) {
-	logger := zaptest.NewLogger(t)
 	ctx := testContext(t)
-	storage, err := on_disk_storage.New(logger, t.TempDir())
-	assert.NoError(t, err)
-	defer storage.Close()
+	storage := mem_storage.New()
 	resolver := Resolver{
 		DB:      testDatabase(ctx, t),
 		Storage: storage,
@@ -60,14 +55,83 @@ func TestGetChunkByID(t *testing.T) {
 	assert.Equal(t, chunkToGraphQL(chunk), *got)
 }
 
+func TestUpdateStreamSettings(t *testing.T) {
+	ctx := testContext(t)
+	ctx = middleware.WithLogger(ctx, zaptest.NewLogger(t))
+	resolver := Resolver{
+		DB:      testDatabase(ctx, t),
+		Storage: mem_storage.New(),
+	}
+	stream := radiochatter.Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, resolver.DB.Save(&stream).Error)
+	noiseThreshold := -45.0
+	padding := 0.25
+	detector := "vad"
+	filter := "voice"
+	keepRaw := true
+	chunkCodec := "ogg"
+
+	got, err := resolver.Mutation().UpdateStream(ctx, modelId(stream), model.UpdateStreamVariables{
+		NoiseThreshold:  &noiseThreshold,
+		Padding:         &padding,
+		SilenceDetector: &detector,
+		AudioFilter:     &filter,
+		KeepRawChunks:   &keepRaw,
+		ChunkCodec:      &chunkCodec,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, -45.0, got.NoiseThreshold)
+	assert.Equal(t, radiochatter.DefaultMinSilence.Seconds(), got.MinSilence)
+	assert.Equal(t, 0.25, got.Padding)
+	assert.Equal(t, "vad", got.SilenceDetector)
+	assert.Equal(t, "voice", got.AudioFilter)
+	assert.True(t, got.KeepRawChunks)
+	assert.Equal(t, "opus", got.ChunkCodec)
+	assert.Equal(t, "opus", got.TransmissionCodec)
+	assert.NoError(t, resolver.DB.First(&stream, stream.ID).Error)
+	assert.Equal(t, -45.0, stream.NoiseThreshold)
+	assert.Equal(t, time.Duration(0), stream.MinSilence)
+	assert.Equal(t, radiochatter.DetectSilenceVAD, stream.SilenceDetector)
+	assert.Equal(t, radiochatter.AudioFilterVoice, stream.AudioFilter)
+	assert.True(t, stream.KeepRawChunks)
+	assert.Equal(t, radiochatter.CodecOpus, stream.ChunkCodec)
+
+	invalid := 10.0
+	_, err = resolver.Mutation().UpdateStream(ctx, modelId(stream), model.UpdateStreamVariables{NoiseThreshold: &invalid})
+
+	assert.Error(t, err)
+}
+
+func TestExpiredChunksHaveNoDownloadURL(t *testing.T) {
+	ctx := testContext(t)
+	storage := mem_storage.New()
+	resolver := Resolver{
+		DB:      testDatabase(ctx, t),
+		Storage: storage,
+	}
+	stream := radiochatter.Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, resolver.DB.Save(&stream).Error)
+	key, err := storage.Store(ctx, []byte("chunk"))
+	assert.NoError(t, err)
+	expiredAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	chunk := radiochatter.Chunk{Sha256: key.String(), StreamID: stream.ID, AudioExpiredAt: &expiredAt}
+	assert.NoError(t, resolver.DB.Save(&chunk).Error)
+
+	got, err := resolver.Query().GetChunkByID(ctx, modelId(chunk))
+	assert.NoError(t, err)
+	url, err := resolver.Chunk().DownloadURL(ctx, got)
+
+	assert.NoError(t, err)
+	assert.Nil(t, url)
+	assert.Equal(t, &expiredAt, got.AudioExpiredAt)
+}
+
 func TestSubscribeToNewChunks(t *testing.T) {
-	logger := zaptest.NewLogger(t)
 	ctx, cancel := context.WithCancel(testContext(t))
 	defer cancel()
 	db := testDatabase(ctx, t)
-	storage, err := on_disk_storage.New(logger, t.TempDir())
-	assert.NoError(t, err)
-	defer storage.Close()
+	storage := mem_storage.New()
 	resolver := Resolver{
 		DB:           db,
 		Storage:      storage,
@@ -110,16 +174,13 @@ func TestSubscribeToNewChunks(t *testing.T) {
 }
 
 func TestSubscribeToTransmissionsForStream(t *testing.T) {
-	logger := zaptest.NewLogger(t)
 	ctx, cancel := context.WithCancel(testContext(t))
 	defer cancel()
 	db := testDatabase(ctx, t)
 	db = db.Session(&gorm.Session{
 		Logger: glog.New(log.New(os.Stderr, "[SQL] ", log.Flags()), glog.Config{LogLevel: glog.Info}),
 	})
-	storage, err := on_disk_storage.New(logger, t.TempDir())
-	assert.NoError(t, err)
-	defer storage.Close()
+	storage := mem_storage.New()
 	resolver := Resolver{
 		DB:           db,
 		Storage:      storage,
@@ -198,3 +259,97 @@ func testContext(t *testing.T) context.Context {
 	t.Cleanup(func() { _ = logger.Sync() })
 	return middleware.WithLogger(context.Background(), logger)
 }
+
+func TestStreamSourceMetadata(t *testing.T) {
+	ctx := testContext(t)
+	resolver := Resolver{
+		DB:      testDatabase(ctx, t),
+		Storage: mem_storage.New(),
+	}
+	stream := radiochatter.Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, resolver.DB.Save(&stream).Error)
+	for _, bitrate := range []int{8, 16} {
+		metadata := radiochatter.SourceMetadata{
+			StreamID: stream.ID,
+			Name:     "Fire Dispatch",
+			Bitrate:  bitrate,
+			Metadata: map[string]string{"icy-pub": "0", "icy-name": "Fire Dispatch"},
+		}
+		assert.NoError(t, resolver.DB.Create(&metadata).Error)
+	}
+	obj := streamToGraphQL(stream)
+
+	source, err := resolver.Stream().Source(ctx, &obj)
+	assert.NoError(t, err)
+	history, err := resolver.Stream().SourceHistory(ctx, &obj)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 16, source.Bitrate)
+	assert.Equal(t, "Fire Dispatch", *source.Name)
+	assert.Nil(t, source.Genre)
+	assert.Equal(
+		t,
+		[]model.MetadataEntry{{Key: "icy-name", Value: "Fire Dispatch"}, {Key: "icy-pub", Value: "0"}},
+		source.Metadata,
+	)
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, 8, history[0].Bitrate)
+		assert.Equal(t, *source, history[1])
+	}
+}
+
+func TestStreamStatsIncludeCoverage(t *testing.T) {
+	ctx := testContext(t)
+	resolver := Resolver{
+		DB:      testDatabase(ctx, t),
+		Storage: mem_storage.New(),
+	}
+	stream := radiochatter.Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, resolver.DB.Save(&stream).Error)
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	chunk := radiochatter.Chunk{StreamID: stream.ID, TimeStamp: from.Add(time.Minute)}
+	assert.NoError(t, resolver.DB.Save(&chunk).Error)
+	transmission := radiochatter.Transmission{ChunkID: chunk.ID, TimeStamp: from.Add(time.Minute)}
+	assert.NoError(t, resolver.DB.Save(&transmission).Error)
+	ended := from.Add(15 * time.Minute)
+	outages := []radiochatter.StreamOutage{
+		{StreamID: stream.ID, StartedAt: from.Add(-time.Hour), EndedAt: &ended, Reason: "404 Not Found"},
+		{StreamID: stream.ID, StartedAt: to.Add(time.Minute), Reason: "recording was stopped"},
+	}
+	assert.NoError(t, resolver.DB.Create(&outages).Error)
+	obj := streamToGraphQL(stream)
+
+	gotOutages, err := resolver.Stream().Outages(ctx, &obj, &from, &to)
+	assert.NoError(t, err)
+	stats, err := resolver.Stream().Stats(ctx, &obj, &from, &to)
+	assert.NoError(t, err)
+
+	if assert.Len(t, gotOutages, 1) {
+		assert.Equal(t, "404 Not Found", gotOutages[0].Reason)
+		assert.Equal(t, ended, *gotOutages[0].End)
+	}
+	assert.Equal(
+		t,
+		&model.StreamStats{
+			From:          from,
+			To:            to,
+			Chunks:        1,
+			Transmissions: 1,
+			Outages:       1,
+			Downtime:      15 * 60,
+			Coverage:      75,
+		},
+		stats,
+	)
+}
+
+func TestChunksReportTheirAudioFormat(t *testing.T) {
+	legacy := chunkToGraphQL(radiochatter.Chunk{})
+	opus := chunkToGraphQL(radiochatter.Chunk{Codec: radiochatter.CodecOpus, MediaType: "audio/ogg"})
+
+	assert.Equal(t, "mp3", legacy.Codec)
+	assert.Equal(t, "audio/mpeg", legacy.MediaType)
+	assert.Equal(t, "opus", opus.Codec)
+	assert.Equal(t, "audio/ogg", opus.MediaType)
+}
@@ -20,9 +20,23 @@ import (
 
 // DownloadURL is the resolver for the downloadUrl field.
 func (r *chunkResolver) DownloadURL(ctx context.Context, obj *model.Chunk) (*string, error) {
+	if obj.AudioExpiredAt != nil {
+		// The audio was deleted by the retention policy
+		return nil, nil
+	}
+
 	return signedURL(ctx, middleware.GetLogger(ctx), r.Storage, obj.Sha256)
 }
 
+// RawDownloadURL is the resolver for the rawDownloadUrl field.
+func (r *chunkResolver) RawDownloadURL(ctx context.Context, obj *model.Chunk) (*string, error) {
+	if obj.RawSha256 == nil || obj.AudioExpiredAt != nil {
+		return nil, nil
+	}
+
+	return signedURL(ctx, middleware.GetLogger(ctx), r.Storage, *obj.RawSha256)
+}
+
 // Transmissions is the resolver for the transmissions field.
 func (r *chunkResolver) Transmissions(ctx context.Context, obj *model.Chunk, after *string, createdAfter *time.Time, count int) (*model.TransmissionsConnection, error) {
 	chunkId, err := decodeModelId[radiochatter.Chunk](obj.ID)
@@ -60,6 +74,25 @@ func (r *mutationResolver) RegisterStream(ctx context.Context, input model.Regis
 		Url:         input.URL,
 	}
 
+	if err := setRetention(&stream, input.ChunkRetention, input.TransmissionRetention); err != nil {
+		return nil, err
+	}
+	if err := setPreprocessOptions(&stream, input.ChunkLength, input.NoiseThreshold, input.MinSilence, input.Padding); err != nil {
+		return nil, err
+	}
+	if err := setCodecs(&stream, input.ChunkCodec, input.TransmissionCodec); err != nil {
+		return nil, err
+	}
+	if err := setAudioFilter(&stream, input.AudioFilter, input.KeepRawChunks); err != nil {
+		return nil, err
+	}
+	if err := setSilenceDetector(&stream, input.SilenceDetector); err != nil {
+		return nil, err
+	}
+	if err := setRestartPolicy(&stream, input.RestartPolicy, input.MaxFailures); err != nil {
+		return nil, err
+	}
+
 	if err := r.DB.Save(&stream).Error; err != nil {
 		return nil, err
 	}
@@ -70,6 +103,53 @@ func (r *mutationResolver) RegisterStream(ctx context.Context, input model.Regis
 	return &model, nil
 }
 
+// UpdateStream is the resolver for the updateStream field.
+func (r *mutationResolver) UpdateStream(ctx context.Context, id string, input model.UpdateStreamVariables) (*model.Stream, error) {
+	realID, err := decodeModelId[radiochatter.Stream](id)
+	if err != nil {
+		return nil, err
+	}
+
+	var stream radiochatter.Stream
+	if err := r.DB.First(&stream, "id = ?", realID).Error; err != nil {
+		return nil, err
+	}
+
+	if input.DisplayName != nil {
+		stream.DisplayName = *input.DisplayName
+	}
+	if input.URL != nil {
+		stream.Url = *input.URL
+	}
+	if err := setRetention(&stream, input.ChunkRetention, input.TransmissionRetention); err != nil {
+		return nil, err
+	}
+	if err := setPreprocessOptions(&stream, input.ChunkLength, input.NoiseThreshold, input.MinSilence, input.Padding); err != nil {
+		return nil, err
+	}
+	if err := setCodecs(&stream, input.ChunkCodec, input.TransmissionCodec); err != nil {
+		return nil, err
+	}
+	if err := setAudioFilter(&stream, input.AudioFilter, input.KeepRawChunks); err != nil {
+		return nil, err
+	}
+	if err := setSilenceDetector(&stream, input.SilenceDetector); err != nil {
+		return nil, err
+	}
+	if err := setRestartPolicy(&stream, input.RestartPolicy, input.MaxFailures); err != nil {
+		return nil, err
+	}
+
+	if err := r.DB.Save(&stream).Error; err != nil {
+		return nil, err
+	}
+
+	middleware.GetLogger(ctx).Info("Stream updated", zap.Any("stream", stream))
+
+	model := streamToGraphQL(stream)
+	return &model, nil
+}
+
 // RemoveStream is the resolver for the removeStream field.
 func (r *mutationResolver) RemoveStream(ctx context.Context, id string) (*model.Stream, error) {
 	realID, err := decodeModelId[radiochatter.Stream](id)
@@ -117,6 +197,152 @@ func (r *queryResolver) GetTransmissionByID(ctx context.Context, id string) (*mo
 	return getByID[radiochatter.Transmission, model.Transmission](r.DB, id, transmissionToGraphQL)
 }
 
+// Status is the resolver for the status field.
+func (r *streamResolver) Status(ctx context.Context, obj *model.Stream) (*model.StreamStatus, error) {
+	streamId, err := decodeModelId[radiochatter.Stream](obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var status radiochatter.StreamStatus
+	err = r.DB.First(&status, "stream_id = ?", streamId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	value := streamStatusToGraphQL(status)
+
+	return &value, nil
+}
+
+// Health is the resolver for the health field.
+func (r *streamResolver) Health(ctx context.Context, obj *model.Stream) (*model.StreamHealth, error) {
+	streamId, err := decodeModelId[radiochatter.Stream](obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var health radiochatter.StreamHealth
+	err = r.DB.First(&health, "stream_id = ?", streamId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	value := streamHealthToGraphQL(health)
+
+	return &value, nil
+}
+
+// Source is the resolver for the source field.
+func (r *streamResolver) Source(ctx context.Context, obj *model.Stream) (*model.SourceMetadata, error) {
+	streamId, err := decodeModelId[radiochatter.Stream](obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata radiochatter.SourceMetadata
+	err = r.DB.Where("stream_id = ?", streamId).Order("id DESC").First(&metadata).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	value := sourceMetadataToGraphQL(metadata)
+
+	return &value, nil
+}
+
+// SourceHistory is the resolver for the sourceHistory field.
+func (r *streamResolver) SourceHistory(ctx context.Context, obj *model.Stream) ([]model.SourceMetadata, error) {
+	streamId, err := decodeModelId[radiochatter.Stream](obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []radiochatter.SourceMetadata
+	if err := r.DB.Where("stream_id = ?", streamId).Order("id").Find(&history).Error; err != nil {
+		return nil, err
+	}
+
+	values := make([]model.SourceMetadata, 0, len(history))
+	for _, metadata := range history {
+		values = append(values, sourceMetadataToGraphQL(metadata))
+	}
+
+	return values, nil
+}
+
+// Outages is the resolver for the outages field.
+func (r *streamResolver) Outages(ctx context.Context, obj *model.Stream, from *time.Time, to *time.Time) ([]model.StreamOutage, error) {
+	streamId, err := decodeModelId[radiochatter.Stream](obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := statsPeriod(obj, from, to, time.Now())
+	outages, err := outagesBetween(r.DB, streamId, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]model.StreamOutage, 0, len(outages))
+	for _, outage := range outages {
+		values = append(values, streamOutageToGraphQL(outage))
+	}
+
+	return values, nil
+}
+
+// Stats is the resolver for the stats field.
+func (r *streamResolver) Stats(ctx context.Context, obj *model.Stream, from *time.Time, to *time.Time) (*model.StreamStats, error) {
+	streamId, err := decodeModelId[radiochatter.Stream](obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	start, end := statsPeriod(obj, from, to, now)
+
+	var chunks int64
+	err = r.DB.Model(&radiochatter.Chunk{}).
+		Where("stream_id = ? AND time_stamp >= ? AND time_stamp < ?", streamId, start, end).
+		Count(&chunks).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	var transmissions int64
+	err = r.DB.Model(&radiochatter.Transmission{}).
+		Joins("JOIN chunks ON chunks.id = transmissions.chunk_id AND chunks.stream_id = ?", streamId).
+		Where("transmissions.time_stamp >= ? AND transmissions.time_stamp < ?", start, end).
+		Count(&transmissions).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	outages, err := outagesBetween(r.DB, streamId, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.StreamStats{
+		From:          start,
+		To:            end,
+		Chunks:        int(chunks),
+		Transmissions: int(transmissions),
+		Outages:       len(outages),
+		Downtime:      radiochatter.Downtime(outages, start, end, now).Seconds(),
+		Coverage:      100 * radiochatter.Coverage(outages, start, end, now),
+	}, nil
+}
+
 // Chunks is the resolver for the chunks field.
 func (r *streamResolver) Chunks(ctx context.Context, obj *model.Stream, after *string, createdAfter *time.Time, count int) (*model.ChunksConnection, error) {
 	streamId, err := decodeModelId[radiochatter.Stream](obj.ID)
@@ -258,6 +484,11 @@ func (r *transcriptionResolver) Transmission(ctx context.Context, obj *model.Tra
 
 // DownloadURL is the resolver for the downloadUrl field.
 func (r *transmissionResolver) DownloadURL(ctx context.Context, obj *model.Transmission) (*string, error) {
+	if obj.AudioExpiredAt != nil {
+		// The audio was deleted by the retention policy
+		return nil, nil
+	}
+
 	return signedURL(ctx, middleware.GetLogger(ctx), r.Storage, obj.Sha256)
 }
 
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	radiochatter "github.com/Michael-F-Bryan/radio-chatter/pkg"
 	"github.com/Michael-F-Bryan/radio-chatter/pkg/middleware"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -14,6 +15,18 @@ import (
 type HealthStatus struct {
 	Ok       bool           `json:"ok"`
 	Database DatabaseHealth `json:"db"`
+	// The latest heartbeat for each stream. These are informational only
+	// because the downloader runs separately from the API server.
+	Streams []StreamHealth `json:"streams,omitempty"`
+}
+
+type StreamHealth struct {
+	ID                uint       `json:"id"`
+	Name              string     `json:"name"`
+	LastAudioReceived *time.Time `json:"last-audio-received,omitempty"`
+	Speed             float64    `json:"speed"`
+	Lagging           bool       `json:"lagging"`
+	UpdatedAt         time.Time  `json:"updated-at"`
 }
 
 type DatabaseHealth struct {
@@ -32,6 +45,14 @@ func Healthz(db *gorm.DB) http.Handler {
 			Database: dbHealth,
 		}
 
+		if dbHealth.Ok {
+			streams, err := streamHealth(r.Context(), db)
+			if err != nil {
+				logger.Warn("Unable to check the streams' health", zap.Error(err))
+			}
+			status.Streams = streams
+		}
+
 		logger.Info("Health check", zap.Any("status", status))
 
 		if status.Ok {
@@ -75,3 +96,39 @@ func databaseHealth(ctx context.Context, db *gorm.DB) DatabaseHealth {
 		ResponseTime: time.Since(started).Seconds(),
 	}
 }
+
+func streamHealth(ctx context.Context, db *gorm.DB) ([]StreamHealth, error) {
+	var streams []radiochatter.Stream
+	if err := db.WithContext(ctx).Find(&streams).Error; err != nil {
+		return nil, err
+	}
+
+	var heartbeats []radiochatter.StreamHealth
+	if err := db.WithContext(ctx).Find(&heartbeats).Error; err != nil {
+		return nil, err
+	}
+
+	names := make(map[uint]string)
+	for _, stream := range streams {
+		names[stream.ID] = stream.DisplayName
+	}
+
+	var health []StreamHealth
+	for _, hb := range heartbeats {
+		name, ok := names[hb.StreamID]
+		if !ok {
+			continue
+		}
+
+		health = append(health, StreamHealth{
+			ID:                hb.StreamID,
+			Name:              name,
+			LastAudioReceived: hb.LastAudioAt,
+			Speed:             hb.Speed,
+			Lagging:           hb.Lagging(),
+			UpdatedAt:         hb.UpdatedAt,
+		})
+	}
+
+	return health, nil
+}
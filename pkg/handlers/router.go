@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"strconv"
+	"strings"
+	"time"
 
 	gql "github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -20,12 +22,13 @@ import (
 	"gorm.io/gorm"
 )
 
-func Router(logger *zap.Logger, db *gorm.DB, storage blob.Storage, devMode bool) http.Handler {
+func Router(logger *zap.Logger, db *gorm.DB, storage blob.Storage, pollInterval time.Duration, devMode bool) http.Handler {
 	r := mux.NewRouter()
 
 	resolver := &graphql.Resolver{
-		DB:      db,
-		Storage: storage,
+		DB:           db,
+		Storage:      storage,
+		PollInterval: pollInterval,
 	}
 	srv := handler.NewDefaultServer(graphql.NewExecutableSchema(graphql.Config{
 		Resolvers: resolver,
@@ -42,6 +45,12 @@ func Router(logger *zap.Logger, db *gorm.DB, storage blob.Storage, devMode bool)
 	r.Path("/graphql/playground").Handler(playground.Handler("GraphQL playground", "/graphql"))
 	r.Path("/graphql/schema.graphql").Methods(http.MethodHead, http.MethodGet).HandlerFunc(graphqlSchema)
 
+	// Some storage backends (e.g. on_disk_storage) can serve their blobs
+	// directly, letting a single port serve both the API and the audio
+	if h, ok := storage.(http.Handler); ok {
+		r.PathPrefix("/blobs/").Methods(http.MethodHead, http.MethodGet).Handler(http.StripPrefix("/blobs", h))
+	}
+
 	if devMode {
 		logger.Info("Registering debug endpoints")
 		sub := r.PathPrefix("/debug/pprof").Subrouter()
@@ -55,7 +64,7 @@ func Router(logger *zap.Logger, db *gorm.DB, storage blob.Storage, devMode bool)
 	return middleware.Apply(
 		r,
 		middleware.Recover(logger.Named("panics")),
-		handlers.CompressHandler,
+		compressUnlessBlob,
 		middleware.RequestID,
 		middleware.Logging(logger),
 		handlers.CORS(
@@ -69,6 +78,21 @@ func Router(logger *zap.Logger, db *gorm.DB, storage blob.Storage, devMode bool)
 	)
 }
 
+// compressUnlessBlob compresses responses, except for blobs. Blobs are usually
+// audio (which is already compressed) and compressing them would break Range
+// requests.
+func compressUnlessBlob(h http.Handler) http.Handler {
+	compressed := handlers.CompressHandler(h)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/blobs/") {
+			h.ServeHTTP(w, r)
+		} else {
+			compressed.ServeHTTP(w, r)
+		}
+	})
+}
+
 func graphqlSchema(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Content-Type", "text/plain")
 	w.Header().Add("Content-Disposition", "attachment; filename='schema.graphql'")
@@ -0,0 +1,121 @@
+package radiochatter
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// InputInfo describes the input ffmpeg is reading from, as printed in the
+// "Input #0" block at startup.
+type InputInfo struct {
+	// The container format (e.g. "mp3").
+	Format string
+	// The overall bitrate, in kb/s. Zero if it isn't known.
+	Bitrate int
+	// The audio codec (e.g. "mp3").
+	Codec string
+	// The audio's sample rate, in Hz.
+	SampleRate int
+	// The channel layout (e.g. "mono").
+	Channels string
+	// Metadata attached to the input, including ICY headers like icy-name
+	// and icy-genre.
+	Metadata map[string]string
+}
+
+// inputPattern matches a line like "Input #0, mp3, from 'https://...':"
+var inputPattern = regexp.MustCompile(`^Input #\d+, ([^,]+), from '.*':$`)
+
+// audioStreamPattern matches a line like "Stream #0:0: Audio: mp3, 8000 Hz, mono, fltp, 8 kb/s"
+var audioStreamPattern = regexp.MustCompile(`^Stream #\d+:\d+\S*: Audio: (.*)$`)
+
+var bitratePattern = regexp.MustCompile(`bitrate: (\d+) kb/s`)
+
+// inputHeader incrementally parses the "Input #0" block that ffmpeg prints to
+// stderr.
+type inputHeader struct {
+	info       *InputInfo
+	inMetadata bool
+	sawAudio   bool
+}
+
+// parse looks at a line of stderr, returning true if it was part of the
+// input header. The header is passed to done once it has been read.
+func (h *inputHeader) parse(line string, done func(InputInfo)) bool {
+	if match := inputPattern.FindStringSubmatch(line); match != nil {
+		h.finish(done)
+		h.info = &InputInfo{
+			Format:   match[1],
+			Metadata: make(map[string]string),
+		}
+		return true
+	}
+
+	if h.info == nil {
+		return false
+	}
+
+	if !strings.HasPrefix(line, " ") {
+		// We've reached the end of the block
+		h.finish(done)
+		return false
+	}
+
+	trimmed := strings.TrimSpace(line)
+
+	switch {
+	case trimmed == "Metadata:":
+		// Note: Only the input's metadata is interesting, not the metadata
+		// attached to individual streams
+		h.inMetadata = line == "  Metadata:"
+	case strings.HasPrefix(trimmed, "Duration:"):
+		h.inMetadata = false
+		if match := bitratePattern.FindStringSubmatch(trimmed); match != nil {
+			h.info.Bitrate, _ = strconv.Atoi(match[1])
+		}
+	case audioStreamPattern.MatchString(trimmed):
+		h.inMetadata = false
+		if !h.sawAudio {
+			h.sawAudio = true
+			h.parseAudioStream(audioStreamPattern.FindStringSubmatch(trimmed)[1])
+		}
+	case h.inMetadata:
+		if key, value, ok := strings.Cut(trimmed, ":"); ok {
+			h.info.Metadata[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	return true
+}
+
+// parseAudioStream parses something like "mp3, 8000 Hz, mono, fltp, 8 kb/s".
+func (h *inputHeader) parseAudioStream(description string) {
+	parts := strings.Split(description, ", ")
+
+	if fields := strings.Fields(parts[0]); len(fields) > 0 {
+		h.info.Codec = fields[0]
+	}
+
+	for i, part := range parts[1:] {
+		switch {
+		case strings.HasSuffix(part, " Hz"):
+			h.info.SampleRate, _ = strconv.Atoi(strings.TrimSuffix(part, " Hz"))
+		case strings.HasSuffix(part, " kb/s"):
+			if h.info.Bitrate == 0 {
+				h.info.Bitrate, _ = strconv.Atoi(strings.TrimSuffix(part, " kb/s"))
+			}
+		case i == 1:
+			h.info.Channels = part
+		}
+	}
+}
+
+// finish emits the header, if we were in the middle of parsing one.
+func (h *inputHeader) finish(done func(InputInfo)) {
+	if h.info != nil {
+		done(*h.info)
+	}
+
+	*h = inputHeader{}
+}
@@ -0,0 +1,187 @@
+// Package mem_storage provides a blob.Storage that keeps everything in memory.
+//
+// It is mainly intended for tests and throwaway dev runs, where nothing needs
+// to outlive the process.
+package mem_storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+)
+
+// MemStorage is a blob.Storage which keeps blobs in memory.
+//
+// Links are "data:" URLs, so they can be used without starting a HTTP server.
+type MemStorage struct {
+	mu    sync.RWMutex
+	blobs map[blob.Key]entry
+	now   func() time.Time
+}
+
+type entry struct {
+	data         []byte
+	lastModified time.Time
+}
+
+// New creates an empty MemStorage.
+func New() *MemStorage {
+	return &MemStorage{
+		blobs: make(map[blob.Key]entry),
+		now:   time.Now,
+	}
+}
+
+func (m *MemStorage) Close() error {
+	return nil
+}
+
+func (m *MemStorage) Link(ctx context.Context, key blob.Key, validFor time.Duration) (*url.URL, error) {
+	data, ok := m.Get(key)
+	if !ok {
+		return nil, fmt.Errorf("unable to find %s: %w", key, blob.ErrNotFound)
+	}
+
+	// Note: data URLs never expire, which is fine because they can only be
+	// used by whoever we hand them to.
+	mediaType := strings.ReplaceAll(blob.DetectMediaType(data), " ", "")
+	encoded := base64.StdEncoding.EncodeToString(data)
+
+	return &url.URL{Scheme: "data", Opaque: mediaType + ";base64," + encoded}, nil
+}
+
+func (m *MemStorage) Store(ctx context.Context, data []byte) (blob.Key, error) {
+	key := blob.KeyForBytes(data)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.blobs[key]; !exists {
+		m.blobs[key] = entry{
+			data:         bytes.Clone(data),
+			lastModified: m.now(),
+		}
+	}
+
+	return key, nil
+}
+
+func (m *MemStorage) StoreReader(ctx context.Context, r io.Reader) (blob.Key, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return blob.Key{}, err
+	}
+
+	return m.Store(ctx, data)
+}
+
+// StoreAt saves a blob under a particular key, replacing any existing blob.
+func (m *MemStorage) StoreAt(ctx context.Context, key blob.Key, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.blobs[key] = entry{data: data, lastModified: m.now()}
+
+	return nil
+}
+
+func (m *MemStorage) Open(ctx context.Context, key blob.Key) (io.ReadCloser, error) {
+	data, ok := m.Get(key)
+	if !ok {
+		return nil, fmt.Errorf("unable to find %s: %w", key, blob.ErrNotFound)
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *MemStorage) List(ctx context.Context, fn func(blob.Info) error) error {
+	m.mu.RLock()
+	infos := make([]blob.Info, 0, len(m.blobs))
+	for key, e := range m.blobs {
+		infos = append(infos, blob.Info{
+			Key:          key,
+			Size:         int64(len(e.data)),
+			LastModified: e.lastModified,
+		})
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(infos, func(i, j int) bool {
+		return bytes.Compare(infos[i].Key[:], infos[j].Key[:]) < 0
+	})
+
+	for _, info := range infos {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(info); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MemStorage) Delete(ctx context.Context, key blob.Key) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.blobs[key]; !ok {
+		return fmt.Errorf("unable to find %s: %w", key, blob.ErrNotFound)
+	}
+
+	delete(m.blobs, key)
+	return nil
+}
+
+// Keys returns the keys for every stored blob, in ascending order.
+func (m *MemStorage) Keys() []blob.Key {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]blob.Key, 0, len(m.blobs))
+	for key := range m.blobs {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i][:], keys[j][:]) < 0
+	})
+
+	return keys
+}
+
+// Get returns a copy of a blob's contents.
+func (m *MemStorage) Get(key blob.Key) ([]byte, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	e, ok := m.blobs[key]
+	if !ok {
+		return nil, false
+	}
+
+	return bytes.Clone(e.data), true
+}
+
+// Len returns the number of stored blobs.
+func (m *MemStorage) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.blobs)
+}
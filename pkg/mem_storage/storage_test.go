@@ -0,0 +1,79 @@
+package mem_storage
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreBlobAndReadItBack(t *testing.T) {
+	storage := New()
+	ctx := context.Background()
+
+	key, err := storage.StoreReader(ctx, strings.NewReader("Hello, World"))
+	assert.NoError(t, err)
+	r, err := storage.Open(ctx, key)
+	assert.NoError(t, err)
+	body, err := io.ReadAll(r)
+	assert.NoError(t, err)
+
+	assert.Equal(t, blob.KeyForBytes([]byte("Hello, World")), key)
+	assert.Equal(t, "Hello, World", string(body))
+	assert.Equal(t, []blob.Key{key}, storage.Keys())
+	assert.Equal(t, 1, storage.Len())
+}
+
+func TestLinksAreDataURLs(t *testing.T) {
+	storage := New()
+	ctx := context.Background()
+	key, err := storage.Store(ctx, []byte("Hello, World"))
+	assert.NoError(t, err)
+
+	link, err := storage.Link(ctx, key, time.Hour)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "data", link.Scheme)
+	encoded := base64.StdEncoding.EncodeToString([]byte("Hello, World"))
+	assert.Equal(t, "data:text/plain;charset=utf-8;base64,"+encoded, link.String())
+}
+
+func TestMissingBlobs(t *testing.T) {
+	storage := New()
+	ctx := context.Background()
+	key := blob.KeyForBytes([]byte("missing"))
+
+	_, err := storage.Open(ctx, key)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	_, err = storage.Link(ctx, key, time.Hour)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	assert.ErrorIs(t, storage.Delete(ctx, key), blob.ErrNotFound)
+}
+
+func TestListAndDeleteBlobs(t *testing.T) {
+	storage := New()
+	ctx := context.Background()
+	first, err := storage.Store(ctx, []byte("first"))
+	assert.NoError(t, err)
+	second, err := storage.Store(ctx, []byte("second"))
+	assert.NoError(t, err)
+
+	var keys []blob.Key
+	err = storage.List(ctx, func(info blob.Info) error {
+		keys = append(keys, info.Key)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []blob.Key{first, second}, keys)
+	assert.Equal(t, storage.Keys(), keys)
+
+	assert.NoError(t, storage.Delete(ctx, first))
+	_, ok := storage.Get(first)
+	assert.False(t, ok)
+	assert.Equal(t, []blob.Key{second}, storage.Keys())
+}
@@ -0,0 +1,174 @@
+package mirrored_storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+)
+
+// entry is a copy which still needs to be made.
+type entry struct {
+	Key blob.Key `json:"key"`
+	// The index of the replica the blob needs to be copied to.
+	Replica int `json:"replica"`
+	// Whether an existing blob should be replaced.
+	Replace bool `json:"replace,omitempty"`
+}
+
+// journal keeps track of the copies that still need to be made in async mode,
+// optionally persisting them to disk so they survive a restart.
+type journal struct {
+	filename string
+	mu       sync.Mutex
+	pending  map[entry]struct{}
+	added    chan struct{}
+	// Held while writing the journal to disk.
+	saveMu sync.Mutex
+}
+
+type journalFile struct {
+	Pending []entry `json:"pending"`
+}
+
+func openJournal(filename string) (*journal, error) {
+	j := &journal{
+		filename: filename,
+		pending:  make(map[entry]struct{}),
+		added:    make(chan struct{}, 1),
+	}
+
+	if filename == "" {
+		return j, nil
+	}
+
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return j, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to read %q: %w", filename, err)
+	}
+
+	var saved journalFile
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("unable to parse %q: %w", filename, err)
+	}
+
+	for _, e := range saved.Pending {
+		j.pending[e] = struct{}{}
+	}
+
+	return j, nil
+}
+
+// Add a copy to the journal and wake up anyone waiting on Added().
+func (j *journal) Add(e entry) {
+	j.mu.Lock()
+	j.pending[e] = struct{}{}
+	j.mu.Unlock()
+
+	select {
+	case j.added <- struct{}{}:
+	default:
+	}
+}
+
+// Added is signalled whenever a copy is added to the journal.
+func (j *journal) Added() <-chan struct{} {
+	return j.added
+}
+
+// Remove a copy once it has been completed.
+func (j *journal) Remove(e entry) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	delete(j.pending, e)
+}
+
+// Forget any copies of a blob, e.g. because it was deleted.
+func (j *journal) Forget(key blob.Key) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	for e := range j.pending {
+		if e.Key == key {
+			delete(j.pending, e)
+		}
+	}
+}
+
+// Pending gets a snapshot of the copies that still need to be made.
+func (j *journal) Pending() []entry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entries := make([]entry, 0, len(j.pending))
+	for e := range j.pending {
+		entries = append(entries, e)
+	}
+
+	sort.Slice(entries, func(a, b int) bool {
+		if entries[a].Key != entries[b].Key {
+			return entries[a].Key.String() < entries[b].Key.String()
+		}
+		return entries[a].Replica < entries[b].Replica
+	})
+
+	return entries
+}
+
+func (j *journal) Len() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return len(j.pending)
+}
+
+// Save the journal to disk.
+func (j *journal) Save() error {
+	if j.filename == "" {
+		return nil
+	}
+
+	// Note: Saves happen concurrently from every Store() call and the
+	// background copier. They are serialized so the journal on disk is always
+	// the most recent snapshot.
+	j.saveMu.Lock()
+	defer j.saveMu.Unlock()
+
+	data, err := json.Marshal(journalFile{Pending: j.Pending()})
+	if err != nil {
+		return err
+	}
+
+	// Note: write to a temporary file and rename it so a crash can't leave us
+	// with a half-written journal
+	f, err := os.CreateTemp(filepath.Dir(j.filename), filepath.Base(j.filename)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("unable to save %q: %w", j.filename, err)
+	}
+	tmp := f.Name()
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("unable to save %q: %w", tmp, err)
+	}
+
+	if err := os.Rename(tmp, j.filename); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("unable to save %q: %w", j.filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package mirrored_storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"go.uber.org/zap"
+)
+
+// ReconcileReport summarises a reconciliation run.
+type ReconcileReport struct {
+	DryRun bool `json:"dry-run"`
+	// How many blobs each replica had before reconciling.
+	Blobs []int `json:"blobs"`
+	// The blobs that were (or would be) copied.
+	Copied []CopiedBlob `json:"copied"`
+	// Copies that failed.
+	Failed []FailedCopy `json:"failed"`
+}
+
+// CopiedBlob is a blob that was copied from one replica to another.
+type CopiedBlob struct {
+	Key  string `json:"key"`
+	From int    `json:"from"`
+	To   int    `json:"to"`
+}
+
+// FailedCopy is a copy that couldn't be made.
+type FailedCopy struct {
+	CopiedBlob
+	Error string `json:"error"`
+}
+
+// Reconcile makes sure every replica has every blob, copying anything that is
+// missing from whichever replica has it (preferring the primary).
+func (m *MirroredStorage) Reconcile(ctx context.Context, dryRun bool) (ReconcileReport, error) {
+	report := ReconcileReport{DryRun: dryRun}
+
+	contents := make([]map[blob.Key]struct{}, len(m.replicas))
+	var keys []blob.Key
+	seen := make(map[blob.Key]struct{})
+
+	for i, replica := range m.replicas {
+		contents[i] = make(map[blob.Key]struct{})
+
+		err := replica.List(ctx, func(info blob.Info) error {
+			contents[i][info.Key] = struct{}{}
+			if _, ok := seen[info.Key]; !ok {
+				seen[info.Key] = struct{}{}
+				keys = append(keys, info.Key)
+			}
+			return nil
+		})
+		if err != nil {
+			return report, fmt.Errorf("unable to list the blobs in replica %d: %w", i, err)
+		}
+
+		report.Blobs = append(report.Blobs, len(contents[i]))
+	}
+
+	for _, key := range keys {
+		source := -1
+		for i := range m.replicas {
+			if _, ok := contents[i][key]; ok {
+				source = i
+				break
+			}
+		}
+
+		for i, replica := range m.replicas {
+			if _, ok := contents[i][key]; ok {
+				continue
+			}
+
+			copied := CopiedBlob{Key: key.String(), From: source, To: i}
+
+			if !dryRun {
+				if err := copyBlob(ctx, m.replicas[source], replica, key, false); err != nil {
+					if ctx.Err() != nil {
+						return report, ctx.Err()
+					}
+					m.logger.Warn("Unable to copy a blob", zap.Any("copy", copied), zap.Error(err))
+					report.Failed = append(report.Failed, FailedCopy{CopiedBlob: copied, Error: err.Error()})
+					continue
+				}
+
+				m.logger.Info("Copied a missing blob", zap.Any("copy", copied))
+
+				if m.journal != nil {
+					m.journal.Remove(entry{Key: key, Replica: i})
+				}
+			}
+
+			report.Copied = append(report.Copied, copied)
+		}
+	}
+
+	if m.journal != nil && !dryRun {
+		if err := m.journal.Save(); err != nil {
+			m.logger.Warn("Unable to save the journal", zap.Error(err))
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,364 @@
+// Package mirrored_storage provides a blob.Storage wrapper which copies every
+// blob to several replicas.
+package mirrored_storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"go.uber.org/zap"
+)
+
+// Mode controls when a Store() is considered complete.
+type Mode string
+
+const (
+	// ModeSync waits until a blob has been saved to every replica.
+	ModeSync Mode = "sync"
+	// ModeAsync returns as soon as a blob has been saved to the primary, and
+	// copies it to the secondaries in the background.
+	ModeAsync Mode = "async"
+)
+
+// DefaultRetryInterval is how long to wait before retrying a failed copy in
+// async mode.
+const DefaultRetryInterval = 1 * time.Minute
+
+// Config contains the settings used by MirroredStorage.
+type Config struct {
+	// When a Store() is considered complete. Defaults to ModeSync.
+	Mode Mode
+	// In async mode, a file used to keep track of the blobs that still need
+	// to be copied to a secondary. Without it, pending copies are lost when
+	// the process exits (use "blob reconcile" to catch up).
+	JournalFile string
+	// How long to wait before retrying a failed copy in async mode.
+	RetryInterval time.Duration
+}
+
+// MirroredStorage saves every blob to a primary replica and one or more
+// secondaries.
+//
+// Reads go to the primary and fall back to the secondaries when the primary
+// doesn't have a blob. List() only looks at the primary.
+type MirroredStorage struct {
+	logger   *zap.Logger
+	replicas []blob.Storage
+	mode     Mode
+	journal  *journal
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// New creates a MirroredStorage where the first replica is the primary.
+func New(logger *zap.Logger, replicas []blob.Storage, cfg Config) (*MirroredStorage, error) {
+	if len(replicas) == 0 {
+		return nil, errors.New("no replicas provided")
+	}
+
+	mode := cfg.Mode
+	if mode == "" {
+		mode = ModeSync
+	}
+	if mode != ModeSync && mode != ModeAsync {
+		return nil, fmt.Errorf("unknown mirroring mode, %q, expected %q or %q", mode, ModeSync, ModeAsync)
+	}
+
+	retryInterval := cfg.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = DefaultRetryInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &MirroredStorage{
+		logger:   logger,
+		replicas: replicas,
+		mode:     mode,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+
+	if mode == ModeAsync {
+		j, err := openJournal(cfg.JournalFile)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		m.journal = j
+
+		if pending := j.Len(); pending > 0 {
+			logger.Info("Resuming copies from the journal", zap.Int("pending", pending))
+		}
+
+		go m.copyInBackground(ctx, retryInterval)
+	} else {
+		close(m.done)
+	}
+
+	return m, nil
+}
+
+// Close stops copying in the background and closes every replica.
+//
+// Any copies that are still pending will be resumed from the journal the next
+// time a MirroredStorage is created.
+func (m *MirroredStorage) Close() error {
+	m.cancel()
+	<-m.done
+
+	var errs []error
+	for _, replica := range m.replicas {
+		errs = append(errs, replica.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *MirroredStorage) primary() blob.Storage {
+	return m.replicas[0]
+}
+
+func (m *MirroredStorage) Link(ctx context.Context, key blob.Key, validFor time.Duration) (*url.URL, error) {
+	var link *url.URL
+
+	err := m.firstReplica(func(replica blob.Storage) error {
+		l, err := replica.Link(ctx, key, validFor)
+		link = l
+		return err
+	})
+
+	return link, err
+}
+
+func (m *MirroredStorage) Open(ctx context.Context, key blob.Key) (io.ReadCloser, error) {
+	var r io.ReadCloser
+
+	err := m.firstReplica(func(replica blob.Storage) error {
+		opened, err := replica.Open(ctx, key)
+		r = opened
+		return err
+	})
+
+	return r, err
+}
+
+// firstReplica tries each replica in turn until one of them has the blob.
+func (m *MirroredStorage) firstReplica(fn func(replica blob.Storage) error) error {
+	var err error
+
+	for i, replica := range m.replicas {
+		err = fn(replica)
+		if !errors.Is(err, blob.ErrNotFound) {
+			if err == nil && i > 0 {
+				m.logger.Warn("Falling back to a secondary replica", zap.Int("replica", i))
+			}
+			return err
+		}
+	}
+
+	return err
+}
+
+func (m *MirroredStorage) Store(ctx context.Context, data []byte) (blob.Key, error) {
+	key, err := m.primary().Store(ctx, data)
+	if err != nil {
+		return blob.Key{}, err
+	}
+
+	return key, m.mirror(ctx, key, false)
+}
+
+func (m *MirroredStorage) StoreReader(ctx context.Context, r io.Reader) (blob.Key, error) {
+	key, err := m.primary().StoreReader(ctx, r)
+	if err != nil {
+		return blob.Key{}, err
+	}
+
+	return key, m.mirror(ctx, key, false)
+}
+
+// StoreAt saves a blob under a particular key, replacing any existing blob.
+//
+// This is only supported when the primary is a blob.KeyedStorage.
+func (m *MirroredStorage) StoreAt(ctx context.Context, key blob.Key, r io.Reader) error {
+	keyed, ok := m.primary().(blob.KeyedStorage)
+	if !ok {
+		return fmt.Errorf("%T doesn't support saving blobs under a particular key", m.primary())
+	}
+
+	if err := keyed.StoreAt(ctx, key, r); err != nil {
+		return err
+	}
+
+	return m.mirror(ctx, key, true)
+}
+
+// mirror copies a blob from the primary to every secondary, or queues the
+// copies in async mode.
+//
+// Secondaries which already have the blob are skipped unless replace is set.
+func (m *MirroredStorage) mirror(ctx context.Context, key blob.Key, replace bool) error {
+	if m.mode == ModeAsync {
+		for i := 1; i < len(m.replicas); i++ {
+			m.journal.Add(entry{Key: key, Replica: i, Replace: replace})
+		}
+		if err := m.journal.Save(); err != nil {
+			m.logger.Warn("Unable to save the journal", zap.Error(err))
+		}
+		return nil
+	}
+
+	var errs []error
+	for i := 1; i < len(m.replicas); i++ {
+		if err := copyBlob(ctx, m.primary(), m.replicas[i], key, replace); err != nil {
+			errs = append(errs, fmt.Errorf("unable to mirror %s to replica %d: %w", key, i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ServeHTTP passes requests through to the primary if it is able to serve
+// blobs directly (e.g. on_disk_storage), so mirroring doesn't break links that
+// point at it.
+func (m *MirroredStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h, ok := m.primary().(http.Handler); ok {
+		h.ServeHTTP(w, r)
+	} else {
+		http.NotFound(w, r)
+	}
+}
+
+// List calls fn for every blob in the primary.
+func (m *MirroredStorage) List(ctx context.Context, fn func(blob.Info) error) error {
+	return m.primary().List(ctx, fn)
+}
+
+// Delete a blob from every replica, returning blob.ErrNotFound if none of
+// them had it.
+func (m *MirroredStorage) Delete(ctx context.Context, key blob.Key) error {
+	found := false
+	var errs []error
+
+	for i, replica := range m.replicas {
+		err := replica.Delete(ctx, key)
+		if errors.Is(err, blob.ErrNotFound) {
+			continue
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("unable to delete %s from replica %d: %w", key, i, err))
+		} else {
+			found = true
+		}
+	}
+
+	if m.journal != nil {
+		m.journal.Forget(key)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("unable to find %s: %w", key, blob.ErrNotFound)
+	}
+
+	return nil
+}
+
+// copyInBackground works through the journal until the MirroredStorage is
+// closed.
+func (m *MirroredStorage) copyInBackground(ctx context.Context, retryInterval time.Duration) {
+	defer close(m.done)
+
+	for {
+		for _, entry := range m.journal.Pending() {
+			if ctx.Err() != nil {
+				break
+			}
+
+			err := copyBlob(ctx, m.primary(), m.replicas[entry.Replica], entry.Key, entry.Replace)
+			if err != nil {
+				m.logger.Warn(
+					"Unable to mirror a blob. Will retry later.",
+					zap.Stringer("key", entry.Key),
+					zap.Int("replica", entry.Replica),
+					zap.Error(err),
+				)
+				continue
+			}
+
+			m.journal.Remove(entry)
+		}
+
+		if err := m.journal.Save(); err != nil {
+			m.logger.Warn("Unable to save the journal", zap.Error(err))
+		}
+
+		timer := time.NewTimer(retryInterval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-m.journal.Added():
+		case <-timer.C:
+		}
+
+		timer.Stop()
+	}
+}
+
+// copyBlob copies a blob from one replica to another, making sure it keeps the
+// same key.
+//
+// Nothing is copied if the destination already has the blob, unless replace
+// is set.
+func copyBlob(ctx context.Context, from, to blob.Storage, key blob.Key, replace bool) error {
+	if !replace {
+		// Note: Asking for a link is a cheap way to check whether a blob
+		// exists
+		_, err := to.Link(ctx, key, time.Minute)
+		if err == nil {
+			return nil
+		} else if !errors.Is(err, blob.ErrNotFound) {
+			return err
+		}
+	}
+
+	r, err := from.Open(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	// Note: The blob might have been transformed by a wrapper (e.g. it was
+	// encrypted), so we need to save it under the original key where possible
+	if keyed, ok := to.(blob.KeyedStorage); ok {
+		return keyed.StoreAt(ctx, key, r)
+	}
+
+	copied, err := to.StoreReader(ctx, r)
+	if err != nil {
+		return err
+	}
+	if copied != key {
+		return fmt.Errorf("expected the copy to be saved as %s, but it was saved as %s", key, copied)
+	}
+
+	return nil
+}
+
+// Pending returns the number of copies that haven't been completed yet.
+func (m *MirroredStorage) Pending() int {
+	if m.journal == nil {
+		return 0
+	}
+
+	return m.journal.Len()
+}
@@ -0,0 +1,162 @@
+package mirrored_storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSyncModeWritesToEveryReplica(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := mem_storage.New(), mem_storage.New()
+	storage := testStorage(t, Config{}, primary, secondary)
+
+	key, err := storage.StoreReader(ctx, strings.NewReader("Hello, World"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []blob.Key{key}, primary.Keys())
+	assert.Equal(t, []blob.Key{key}, secondary.Keys())
+}
+
+func TestAsyncModeCopiesInTheBackground(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := mem_storage.New(), mem_storage.New()
+	journal := filepath.Join(t.TempDir(), "journal.json")
+	storage := testStorage(t, Config{Mode: ModeAsync, JournalFile: journal}, primary, secondary)
+
+	key, err := storage.Store(ctx, []byte("Hello, World"))
+
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return secondary.Len() == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []blob.Key{key}, secondary.Keys())
+	assert.Eventually(t, func() bool { return storage.Pending() == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestAsyncModeResumesFromTheJournal(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := mem_storage.New(), mem_storage.New()
+	key, err := primary.Store(ctx, []byte("Hello, World"))
+	assert.NoError(t, err)
+	filename := filepath.Join(t.TempDir(), "journal.json")
+	j, err := openJournal(filename)
+	assert.NoError(t, err)
+	j.Add(entry{Key: key, Replica: 1})
+	assert.NoError(t, j.Save())
+
+	testStorage(t, Config{Mode: ModeAsync, JournalFile: filename}, primary, secondary)
+
+	assert.Eventually(t, func() bool { return secondary.Len() == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestConcurrentJournalSaves(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "journal.json")
+	j, err := openJournal(filename)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			j.Add(entry{Key: blob.KeyForBytes([]byte(fmt.Sprint(i))), Replica: 1})
+			assert.NoError(t, j.Save())
+		}(i)
+	}
+	wg.Wait()
+
+	// The last save should have seen every entry, and no temporary files
+	// should be left lying around
+	reopened, err := openJournal(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, reopened.Len())
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestReadsFallBackToSecondaries(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := mem_storage.New(), mem_storage.New()
+	key, err := secondary.Store(ctx, []byte("Only on the secondary"))
+	assert.NoError(t, err)
+	storage := testStorage(t, Config{}, primary, secondary)
+
+	link, err := storage.Link(ctx, key, time.Hour)
+	assert.NoError(t, err)
+	assert.NotNil(t, link)
+	r, err := storage.Open(ctx, key)
+	assert.NoError(t, err)
+	defer r.Close()
+	body, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "Only on the secondary", string(body))
+
+	_, err = storage.Link(ctx, blob.KeyForBytes([]byte("missing")), time.Hour)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestDeleteRemovesFromEveryReplica(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := mem_storage.New(), mem_storage.New()
+	storage := testStorage(t, Config{}, primary, secondary)
+	key, err := storage.Store(ctx, []byte("Hello, World"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, storage.Delete(ctx, key))
+
+	assert.Zero(t, primary.Len())
+	assert.Zero(t, secondary.Len())
+	assert.ErrorIs(t, storage.Delete(ctx, key), blob.ErrNotFound)
+}
+
+func TestReconcileCopiesMissingBlobs(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := mem_storage.New(), mem_storage.New()
+	onPrimary, err := primary.Store(ctx, []byte("primary"))
+	assert.NoError(t, err)
+	onSecondary, err := secondary.Store(ctx, []byte("secondary"))
+	assert.NoError(t, err)
+	storage := testStorage(t, Config{}, primary, secondary)
+
+	// Do a dry run first
+	report, err := storage.Reconcile(ctx, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 1}, report.Blobs)
+	assert.Len(t, report.Copied, 2)
+	assert.Equal(t, 1, primary.Len())
+
+	// Then do it for real
+	report, err = storage.Reconcile(ctx, false)
+
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []CopiedBlob{
+		{Key: onPrimary.String(), From: 0, To: 1},
+		{Key: onSecondary.String(), From: 1, To: 0},
+	}, report.Copied)
+	assert.Empty(t, report.Failed)
+	assert.ElementsMatch(t, []blob.Key{onPrimary, onSecondary}, primary.Keys())
+	assert.ElementsMatch(t, []blob.Key{onPrimary, onSecondary}, secondary.Keys())
+}
+
+func testStorage(t *testing.T, cfg Config, replicas ...blob.Storage) *MirroredStorage {
+	t.Helper()
+
+	storage, err := New(zaptest.NewLogger(t), replicas, cfg)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = storage.Close() })
+
+	return storage
+}
@@ -3,6 +3,7 @@ package radiochatter
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
@@ -19,8 +20,91 @@ type Stream struct {
 	DisplayName string `gorm:"unique"`
 	// A URL that can be passed to ffmpeg to download the stream.
 	Url string
+	// How long to keep the audio for raw chunks, or zero to keep it forever.
+	ChunkRetention time.Duration
+	// How long to keep the audio for transmissions, or zero to keep it
+	// forever.
+	TransmissionRetention time.Duration
+	// How long each chunk should be. Zero uses the deployment's default.
+	ChunkLength time.Duration
+	// The format chunks are saved in. Empty uses CodecMP3.
+	ChunkCodec AudioCodec
+	// The format transmissions are saved in. Empty uses the same codec as
+	// the chunks.
+	TransmissionCodec AudioCodec
+	// How the audio is cleaned up before looking for transmissions. Empty
+	// uses AudioFilterNone.
+	AudioFilter AudioFilter
+	// Keep the raw audio for each chunk alongside the cleaned up version
+	// (e.g. so it can be used as evidence). This does nothing unless the
+	// stream has an audio filter.
+	KeepRawChunks bool
+	// How silence is detected. Empty uses DetectSilenceFFmpeg.
+	SilenceDetector SilenceDetector
+	// Audio quieter than this (in dB) is treated as silence. Zero uses
+	// DefaultNoiseThreshold.
+	NoiseThreshold float64
+	// How long the audio needs to be quiet before it counts as silence. Zero
+	// uses DefaultMinSilence.
+	MinSilence time.Duration
+	// How much audio to keep either side of a transmission. Nil uses
+	// DefaultPadding.
+	Padding *time.Duration
+	// When to restart the stream after it stops. Empty means RestartAlways.
+	RestartPolicy RestartPolicy
+	// Stop trying to record the stream after this many consecutive failures.
+	// Zero means never give up.
+	MaxFailures int
 	// Downloaded chunks.
 	Chunks []Chunk `gorm:"constraint:OnDelete:CASCADE"`
+	// Times the stream had to be reconnected.
+	Reconnects []Reconnect `gorm:"constraint:OnDelete:CASCADE"`
+	// What the stream's server has said about itself over time.
+	SourceMetadata []SourceMetadata `gorm:"constraint:OnDelete:CASCADE"`
+	// Times when the stream wasn't being recorded.
+	Outages []StreamOutage `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// PreprocessOptions gets the settings used to split this stream into
+// transmissions, using the package defaults for anything that hasn't been set.
+func (s Stream) PreprocessOptions() PreprocessOptions {
+	return s.PreprocessOptionsWithDefaults(DefaultPreprocessOptions())
+}
+
+// PreprocessOptionsWithDefaults gets the settings used to split this stream
+// into transmissions, falling back to the provided defaults.
+func (s Stream) PreprocessOptionsWithDefaults(defaults PreprocessOptions) PreprocessOptions {
+	opts := defaults
+
+	if s.ChunkLength != 0 {
+		opts.ChunkLength = s.ChunkLength
+	}
+	if s.ChunkCodec != "" {
+		opts.ChunkCodec = s.ChunkCodec
+	}
+	if s.TransmissionCodec != "" {
+		opts.TransmissionCodec = s.TransmissionCodec
+	} else {
+		opts.TransmissionCodec = opts.ChunkCodec
+	}
+	if s.AudioFilter != "" {
+		opts.AudioFilter = s.AudioFilter
+	}
+	opts.KeepRawChunks = s.KeepRawChunks
+	if s.SilenceDetector != "" {
+		opts.SilenceDetector = s.SilenceDetector
+	}
+	if s.NoiseThreshold != 0 {
+		opts.NoiseThreshold = s.NoiseThreshold
+	}
+	if s.MinSilence != 0 {
+		opts.MinSilence = s.MinSilence
+	}
+	if s.Padding != nil {
+		opts.Padding = *s.Padding
+	}
+
+	return opts
 }
 
 // Chunk is a raw chunk of audio downloaded from a particular stream.
@@ -28,10 +112,24 @@ type Chunk struct {
 	gorm.Model
 	// When the audio was produced.
 	TimeStamp time.Time
+	// How long the chunk is. Zero for chunks archived before this was
+	// recorded.
+	Length time.Duration
+	// The format the audio is saved in. Empty for chunks archived before
+	// this was recorded, which are always CodecMP3.
+	Codec AudioCodec
+	// The audio's MIME type (e.g. "audio/mpeg").
+	MediaType string
 	// A hex-encoded hash of the audio clip.
 	Sha256 string
+	// A hex-encoded hash of the chunk's audio before it was cleaned up by the
+	// stream's audio filter. Empty unless the stream keeps raw chunks.
+	RawSha256 string
 	// The stream this clip belongs to.
 	StreamID uint
+	// When the chunk's audio was deleted by the retention job. The row is
+	// kept around so its transmissions aren't lost.
+	AudioExpiredAt *time.Time
 	// Messages that were transmitted in this chunk.
 	Transmissions []Transmission `gorm:"constraint:OnDelete:CASCADE"`
 }
@@ -43,11 +141,18 @@ type Transmission struct {
 	TimeStamp time.Time
 	// How long the transmission goes for.
 	Length time.Duration
+	// The format the audio is saved in. Empty for transmissions archived
+	// before this was recorded, which are always CodecMP3.
+	Codec AudioCodec
+	// The audio's MIME type (e.g. "audio/mpeg").
+	MediaType string
 	// A hex-encoded hash of the audio clip.
 	Sha256 string
 	// The chunk this transmission came from.
-	ChunkID       uint
-	Transcription *Transcription `gorm:"constraint:OnDelete:CASCADE"`
+	ChunkID uint
+	// When the transmission's audio was deleted by the retention job.
+	AudioExpiredAt *time.Time
+	Transcription  *Transcription `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 // Transcription is the result of running speech-to-text on a Transmission.
@@ -58,9 +163,141 @@ type Transcription struct {
 	Content string
 }
 
+// Reconnect records a time ffmpeg exited and the stream had to be restarted.
+type Reconnect struct {
+	gorm.Model
+	// The stream that was reconnected.
+	StreamID uint
+	// The recording epoch that was started. Every time ffmpeg is restarted,
+	// a new epoch begins.
+	Epoch int
+	// How many times in a row ffmpeg has exited without staying up long
+	// enough to reset the backoff.
+	Attempt int
+	// When ffmpeg exited.
+	DisconnectedAt time.Time
+	// When ffmpeg was restarted.
+	ReconnectedAt time.Time
+	// Why ffmpeg exited.
+	Reason string
+}
+
+// SourceMetadata is what a stream's server said about itself when ffmpeg
+// connected. A new row is only added when something changes, so together they
+// give a history of changes made upstream.
+type SourceMetadata struct {
+	gorm.Model
+	// The stream this metadata came from.
+	StreamID uint
+	// The stream's name, from the icy-name header.
+	Name string
+	// The stream's genre, from the icy-genre header.
+	Genre string
+	// The container format (e.g. "mp3").
+	Format string
+	// The audio codec (e.g. "mp3").
+	Codec string
+	// The audio's sample rate, in Hz.
+	SampleRate int
+	// The channel layout (e.g. "mono").
+	Channels string
+	// The bitrate, in kb/s.
+	Bitrate int
+	// All the metadata attached to the input.
+	Metadata map[string]string `gorm:"serializer:json"`
+}
+
+// NewSourceMetadata converts the input header ffmpeg printed into
+// SourceMetadata.
+func NewSourceMetadata(info InputInfo) SourceMetadata {
+	return SourceMetadata{
+		Name:       info.Metadata["icy-name"],
+		Genre:      info.Metadata["icy-genre"],
+		Format:     info.Format,
+		Codec:      info.Codec,
+		SampleRate: info.SampleRate,
+		Channels:   info.Channels,
+		Bitrate:    info.Bitrate,
+		Metadata:   info.Metadata,
+	}
+}
+
+// SameAs checks whether two sets of metadata describe the same source,
+// ignoring the database fields.
+func (m SourceMetadata) SameAs(other SourceMetadata) bool {
+	return m.Name == other.Name &&
+		m.Genre == other.Genre &&
+		m.Format == other.Format &&
+		m.Codec == other.Codec &&
+		m.SampleRate == other.SampleRate &&
+		m.Channels == other.Channels &&
+		m.Bitrate == other.Bitrate &&
+		maps.Equal(m.Metadata, other.Metadata)
+}
+
+// StreamOutage is a period where a stream wasn't being recorded, so gaps in
+// the archive can be told apart from times when nobody was talking.
+type StreamOutage struct {
+	gorm.Model
+	// The stream that wasn't being recorded.
+	StreamID uint
+	// When recording stopped.
+	StartedAt time.Time
+	// When recording resumed, or nil if the outage is still going.
+	EndedAt *time.Time
+	// Why recording stopped.
+	Reason string
+}
+
+// StreamState is what a stream's downloader is currently doing.
+type StreamState string
+
+const (
+	StreamRunning    StreamState = "running"
+	StreamBackingOff StreamState = "backing-off"
+	StreamFailed     StreamState = "failed"
+	StreamStopped    StreamState = "stopped"
+)
+
+// StreamStatus is the latest status reported by a stream's downloader.
+type StreamStatus struct {
+	StreamID  uint `gorm:"primaryKey;autoIncrement:false"`
+	UpdatedAt time.Time
+	State     StreamState
+	// How many times in a row the stream has failed.
+	Failures int
+	// The most recent error, if there was one.
+	LastError string
+	// When the downloader will try again, if it is backing off.
+	RetryAt *time.Time
+}
+
+// StreamHealth is a periodic heartbeat describing how well a stream is being
+// recorded, based on ffmpeg's progress reports.
+type StreamHealth struct {
+	StreamID  uint `gorm:"primaryKey;autoIncrement:false"`
+	UpdatedAt time.Time
+	// When ffmpeg last reported receiving more audio.
+	LastAudioAt *time.Time
+	// How much audio ffmpeg has processed since it was last (re)started.
+	AudioTime time.Duration
+	// The output bitrate in kbit/s.
+	Bitrate float64
+	// How fast the audio is being processed, relative to real time.
+	Speed float64
+	// How far the processed audio lags behind the wall clock.
+	Drift time.Duration
+}
+
+// Lagging checks whether the stream is being processed slower than real
+// time.
+func (h StreamHealth) Lagging() bool {
+	return h.Speed > 0 && h.Speed < 1
+}
+
 // Migrate will apply any necessary migrations to the database.
 func Migrate(ctx context.Context, db *gorm.DB) error {
-	return db.WithContext(ctx).AutoMigrate(&Stream{}, &Chunk{}, &Transmission{}, &Transcription{})
+	return db.WithContext(ctx).AutoMigrate(&Stream{}, &Chunk{}, &Transmission{}, &Transcription{}, &Reconnect{}, &StreamStatus{}, &StreamHealth{}, &SourceMetadata{}, &StreamOutage{})
 }
 
 var databaseOpeners = map[string]func(string) gorm.Dialector{
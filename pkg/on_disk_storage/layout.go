@@ -0,0 +1,128 @@
+package on_disk_storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"go.uber.org/zap"
+)
+
+// staleTempFileAge is how old a temporary file needs to be before we assume
+// the process that was writing it has crashed.
+const staleTempFileAge = 1 * time.Hour
+
+// path gets the location a blob is saved to.
+//
+// Blobs are spread across two levels of directories based on the first few
+// characters of their key (e.g. "ab/cd/abcd1234...") so we don't end up with
+// hundreds of thousands of files in a single directory.
+func (s *OnDiskStorage) path(item blob.Key) string {
+	key := item.String()
+	return path.Join(s.rootDir, key[0:2], key[2:4], key)
+}
+
+// mediaTypePath gets the location of the file recording a blob's media type.
+//
+// Note: The suffix means ParseKey() will never mistake it for a blob.
+func (s *OnDiskStorage) mediaTypePath(item blob.Key) string {
+	return s.path(item) + ".type"
+}
+
+// mediaType looks up the media type recorded when a blob was saved.
+//
+// Blobs saved by older versions don't have a recorded media type, so we fall
+// back to sniffing their contents.
+func (s *OnDiskStorage) mediaType(key blob.Key, f io.ReadSeeker) (string, error) {
+	recorded, err := os.ReadFile(s.mediaTypePath(key))
+	if err == nil {
+		return strings.TrimSpace(string(recorded)), nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+
+	return blob.SniffMediaType(f)
+}
+
+// migrateFlatLayout moves blobs saved by older versions, which put every blob
+// directly in the root directory, to their sharded location.
+func (s *OnDiskStorage) migrateFlatLayout() error {
+	entries, err := os.ReadDir(s.rootDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to read %s/: %w", s.rootDir, err)
+	}
+
+	migrated := 0
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		key, err := blob.ParseKey(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		oldPath := path.Join(s.rootDir, entry.Name())
+		newPath := s.path(key)
+
+		if err := os.MkdirAll(path.Dir(newPath), 0766); err != nil {
+			return fmt.Errorf("unable to create %s/: %w", path.Dir(newPath), err)
+		}
+
+		if _, err := os.Stat(newPath); err == nil {
+			// Looks like the blob was saved again after upgrading
+			if err := os.Remove(oldPath); err != nil {
+				return fmt.Errorf("unable to remove the duplicate blob at %q: %w", oldPath, err)
+			}
+			continue
+		}
+
+		if err := os.Rename(oldPath, newPath); err != nil {
+			return fmt.Errorf("unable to move %q to %q: %w", oldPath, newPath, err)
+		}
+		migrated++
+	}
+
+	if migrated > 0 {
+		s.logger.Info(
+			"Migrated blobs from the flat layout",
+			zap.String("root", s.rootDir),
+			zap.Int("count", migrated),
+		)
+	}
+
+	return nil
+}
+
+// removeStaleTempFiles cleans up any temporary files left behind when a
+// process crashes part-way through saving a blob.
+func (s *OnDiskStorage) removeStaleTempFiles() {
+	entries, err := os.ReadDir(s.rootDir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), blob.TempFilePrefix) {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < staleTempFileAge {
+			// Note: Another process might be writing to this file right now
+			continue
+		}
+
+		s.removeTemp(path.Join(s.rootDir, entry.Name()))
+	}
+}
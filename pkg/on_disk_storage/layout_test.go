@@ -0,0 +1,103 @@
+package on_disk_storage
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestBlobsAreSharded(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	dir := t.TempDir()
+	storage, err := New(logger, dir)
+	assert.NoError(t, err)
+	defer storage.Close()
+
+	key, err := storage.Store(context.Background(), []byte("Hello, World"))
+
+	assert.NoError(t, err)
+	hex := key.String()
+	assert.FileExists(t, path.Join(dir, hex[0:2], hex[2:4], hex))
+	assert.NoFileExists(t, path.Join(dir, hex))
+}
+
+func TestMigrateFlatLayout(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	dir := t.TempDir()
+	content := []byte("Hello, World")
+	key := blob.KeyForBytes(content)
+	assert.NoError(t, os.WriteFile(path.Join(dir, key.String()), content, 0666))
+	assert.NoError(t, os.WriteFile(path.Join(dir, "not-a-blob"), content, 0666))
+
+	storage, err := New(logger, dir)
+	assert.NoError(t, err)
+	defer storage.Close()
+
+	assert.NoFileExists(t, path.Join(dir, key.String()))
+	assert.FileExists(t, storage.path(key))
+	assert.FileExists(t, path.Join(dir, "not-a-blob"))
+	r, err := storage.Open(context.Background(), key)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+}
+
+func TestStaleTempFilesAreRemoved(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	dir := t.TempDir()
+	stale := path.Join(dir, blob.TempFilePrefix+"stale")
+	fresh := path.Join(dir, blob.TempFilePrefix+"fresh")
+	assert.NoError(t, os.WriteFile(stale, []byte("half a blo"), 0666))
+	assert.NoError(t, os.WriteFile(fresh, []byte("half a blo"), 0666))
+	longAgo := time.Now().Add(-2 * staleTempFileAge)
+	assert.NoError(t, os.Chtimes(stale, longAgo, longAgo))
+
+	storage, err := New(logger, dir)
+	assert.NoError(t, err)
+	defer storage.Close()
+
+	assert.NoFileExists(t, stale)
+	assert.FileExists(t, fresh)
+}
+
+func TestConcurrentStoresOfTheSameBlob(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	dir := t.TempDir()
+	storage, err := New(logger, dir)
+	assert.NoError(t, err)
+	defer storage.Close()
+	content := strings.Repeat("Hello, World! ", 10_000)
+	var wg sync.WaitGroup
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			if i%2 == 0 {
+				_, err = storage.Store(context.Background(), []byte(content))
+			} else {
+				_, err = storage.StoreReader(context.Background(), strings.NewReader(content))
+			}
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	written, err := os.ReadFile(storage.path(blob.KeyForBytes([]byte(content))))
+	assert.NoError(t, err)
+	assert.Equal(t, content, string(written))
+	// No temporary files should be left lying around
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	for _, entry := range entries {
+		assert.True(t, entry.IsDir(), entry.Name())
+	}
+}
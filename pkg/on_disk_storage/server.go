@@ -1,19 +1,84 @@
 package on_disk_storage
 
 import (
+	"errors"
+	"io/fs"
 	"net/http"
+	"os"
+	"strings"
 
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
 	"github.com/Michael-F-Bryan/radio-chatter/pkg/middleware"
 	"github.com/gorilla/handlers"
 	"go.uber.org/zap"
 )
 
-func server(logger *zap.Logger, rootDir string) http.Handler {
+func server(logger *zap.Logger, storage *OnDiskStorage) http.Handler {
 	return middleware.Apply(
-		http.FileServer(http.Dir(rootDir)),
+		blobHandler(storage),
 		middleware.Recover(logger.Named("panics")),
 		middleware.RequestID,
 		middleware.Logging(logger),
 		handlers.CORS(),
 	)
 }
+
+// blobHandler serves blobs from disk, making sure the caller has provided a
+// valid signature.
+func blobHandler(storage *OnDiskStorage) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := middleware.GetLogger(r.Context())
+
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		key, err := blob.ParseKey(strings.TrimPrefix(r.URL.Path, "/"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		if err := storage.signer.Verify(key, r.URL.Query()); err != nil {
+			logger.Warn("Rejected a blob request", zap.Stringer("key", key), zap.Error(err))
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		filename := storage.path(key)
+		f, err := os.Open(filename)
+		if errors.Is(err, fs.ErrNotExist) {
+			http.NotFound(w, r)
+			return
+		} else if err != nil {
+			logger.Error("Unable to open the blob", zap.String("filename", filename), zap.Error(err))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			logger.Error("Unable to stat the blob", zap.String("filename", filename), zap.Error(err))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		mediaType, err := storage.mediaType(key, f)
+		if err != nil {
+			logger.Error("Unable to determine the media type", zap.String("filename", filename), zap.Error(err))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		// Blobs are content-addressed, so their contents will never change
+		header := w.Header()
+		header.Set("Content-Type", mediaType)
+		header.Set("ETag", `"`+key.String()+`"`)
+		header.Set("Cache-Control", "public, max-age=31536000, immutable")
+
+		// Note: ServeContent() takes care of Range and If-None-Match requests
+		http.ServeContent(w, r, key.String(), info.ModTime(), f)
+	})
+}
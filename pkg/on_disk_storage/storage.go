@@ -4,29 +4,120 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
+	"path/filepath"
 	"runtime"
 	"time"
 
 	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 )
 
+// NoListen can be used as Config.ListenAddr to disable the built-in HTTP
+// server.
+const NoListen = "none"
+
+// Config contains the settings used by OnDiskStorage.
+type Config struct {
+	// The directory blobs are saved to.
+	RootDir string
+	// The secret used to sign links. If empty, a random secret will be
+	// generated and links will only be accepted by this instance.
+	Secret []byte
+	// The address the built-in HTTP server listens on. Defaults to a random
+	// port.
+	//
+	// Use NoListen to disable the server when blobs are served by mounting
+	// the OnDiskStorage on another router.
+	ListenAddr string
+	// The public URL that blobs are served under (e.g.
+	// "https://audio.example/blobs"). Defaults to the built-in server's
+	// address.
+	BaseURL string
+}
+
+// New creates an OnDiskStorage which saves blobs to the provided directory
+// and signs links using a randomly generated secret.
 func New(logger *zap.Logger, rootDir string) (*OnDiskStorage, error) {
-	conn, err := net.Listen("tcp", ":0")
-	if err != nil {
-		return nil, err
+	return NewWithConfig(logger, Config{RootDir: rootDir})
+}
+
+// NewWithConfig creates an OnDiskStorage and starts a HTTP server which can be
+// used to download its blobs.
+func NewWithConfig(logger *zap.Logger, cfg Config) (*OnDiskStorage, error) {
+	rootDir := cfg.RootDir
+	secret := cfg.Secret
+
+	if len(secret) == 0 {
+		s, err := blob.RandomSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = s
+	}
+
+	var baseURL *url.URL
+	if cfg.BaseURL != "" {
+		u, err := url.Parse(cfg.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base URL: %w", err)
+		}
+		baseURL = u
+	} else if cfg.ListenAddr == NoListen {
+		return nil, errors.New("a base URL is required when the built-in server is disabled")
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	storage := &OnDiskStorage{
+		rootDir: rootDir,
+		logger:  logger,
+		cancel:  cancel,
+		signer:  blob.NewLinkSigner(secret),
+	}
+
+	if err := storage.migrateFlatLayout(); err != nil {
+		cancel()
+		return nil, err
+	}
+	storage.removeStaleTempFiles()
+
+	if cfg.ListenAddr == NoListen {
+		errChan := make(chan error)
+		close(errChan)
+		storage.errors = errChan
+		storage.baseURL = baseURL
+		return storage, nil
+	}
+
+	listenAddr := cfg.ListenAddr
+	if listenAddr == "" {
+		listenAddr = ":0"
+	}
+
+	conn, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
 	addr := conn.Addr()
+	storage.addr = addr
+
+	if baseURL == nil {
+		baseURL = &url.URL{Scheme: "http", Host: addr.String()}
+	}
+	storage.baseURL = baseURL
+
 	server := &http.Server{
-		Handler:     server(logger, rootDir),
+		Handler:     server(logger, storage),
 		Addr:        addr.String(),
 		BaseContext: func(l net.Listener) context.Context { return ctx },
 	}
@@ -50,13 +141,7 @@ func New(logger *zap.Logger, rootDir string) (*OnDiskStorage, error) {
 		}
 	}()
 
-	storage := &OnDiskStorage{
-		rootDir: rootDir,
-		logger:  logger,
-		cancel:  cancel,
-		errors:  errChan,
-		addr:    addr,
-	}
+	storage.errors = errChan
 
 	// HACK: Make sure we don't leave dangling servers if the caller forgets to
 	// shut down manually
@@ -79,11 +164,14 @@ func New(logger *zap.Logger, rootDir string) (*OnDiskStorage, error) {
 }
 
 type OnDiskStorage struct {
-	rootDir string
-	logger  *zap.Logger
-	cancel  context.CancelFunc
-	errors  <-chan error
-	addr    net.Addr
+	rootDir  string
+	logger   *zap.Logger
+	cancel   context.CancelFunc
+	errors   <-chan error
+	addr     net.Addr
+	baseURL  *url.URL
+	signer   *blob.LinkSigner
+	inflight singleflight.Group
 }
 
 func (s *OnDiskStorage) Close() error {
@@ -91,12 +179,18 @@ func (s *OnDiskStorage) Close() error {
 	return <-s.errors
 }
 
+// Addr is the address the built-in HTTP server is listening on, or nil if it
+// has been disabled.
 func (s *OnDiskStorage) Addr() net.Addr {
 	return s.addr
 }
 
-func (s *OnDiskStorage) path(item blob.Key) string {
-	return path.Join(s.rootDir, item.String())
+// ServeHTTP serves blobs using the same signed links as the built-in server.
+//
+// The blob's key is expected to be the request path, so callers mounting the
+// OnDiskStorage under a prefix should use http.StripPrefix().
+func (s *OnDiskStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	blobHandler(s).ServeHTTP(w, r)
 }
 
 func (s *OnDiskStorage) Link(ctx context.Context, key blob.Key, validFor time.Duration) (*url.URL, error) {
@@ -111,41 +205,259 @@ func (s *OnDiskStorage) Link(ctx context.Context, key blob.Key, validFor time.Du
 		return nil, fmt.Errorf("unable to find %q: %w", filename, err)
 	}
 
-	raw := fmt.Sprintf("http://%s/%s", s.addr, key)
-	return url.Parse(raw)
+	link := s.baseURL.JoinPath(key.String())
+
+	return s.signer.Sign(link, key, validFor), nil
 }
 
 func (s *OnDiskStorage) Store(ctx context.Context, data []byte) (blob.Key, error) {
 	key := blob.KeyForBytes(data)
-	filename := s.path(key)
+	mediaType := blob.DetectMediaType(data)
+
+	err := s.save(key, mediaType, false, func() (string, error) {
+		f, err := os.CreateTemp(s.rootDir, blob.TempFilePrefix+"*")
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+
+		if _, err := f.Write(data); err != nil {
+			return f.Name(), err
+		}
+
+		return f.Name(), f.Sync()
+	})
+	if err != nil {
+		return blob.Key{}, err
+	}
+
+	return key, nil
+}
+
+func (s *OnDiskStorage) StoreReader(ctx context.Context, r io.Reader) (blob.Key, error) {
+	return s.storeReader(r, nil)
+}
 
-	parent := path.Dir(filename)
-	if err := os.MkdirAll(parent, 0766); err != nil {
+// StoreAt saves a blob under a particular key, replacing any existing blob.
+func (s *OnDiskStorage) StoreAt(ctx context.Context, key blob.Key, r io.Reader) error {
+	_, err := s.storeReader(r, &key)
+	return err
+}
+
+// storeReader spools a blob to disk and saves it under its own key, or the
+// provided key when one is given.
+func (s *OnDiskStorage) storeReader(r io.Reader, key *blob.Key) (blob.Key, error) {
+	if err := os.MkdirAll(s.rootDir, 0766); err != nil {
 		return blob.Key{}, fmt.Errorf("unable to create %s/: %w", s.rootDir, err)
 	}
 
-	if _, err := os.Stat(filename); err == nil {
+	// Note: The temporary file needs to be on the same filesystem as the final
+	// destination so we can rename it into place.
+	f, contentKey, _, err := blob.Spool(s.rootDir, r)
+	if err != nil {
+		return blob.Key{}, err
+	}
+	mediaType, err := blob.SniffMediaType(f)
+	_ = f.Close()
+	// Note: If another goroutine is already saving this blob, save() won't
+	// touch our temporary file and we need to clean it up ourselves.
+	defer s.removeTemp(f.Name())
+	if err != nil {
+		return blob.Key{}, fmt.Errorf("unable to detect the media type of %s: %w", contentKey, err)
+	}
+
+	replace := key != nil
+	if !replace {
+		key = &contentKey
+	}
+
+	err = s.save(*key, mediaType, replace, func() (string, error) { return f.Name(), nil })
+	if err != nil {
+		return blob.Key{}, err
+	}
+
+	return *key, nil
+}
+
+// save will atomically write a blob to disk.
+//
+// The writeTemp function is responsible for writing the blob's contents to a
+// temporary file in the root directory. Once it has completed successfully,
+// the temporary file will be renamed into place. That way a crash part-way
+// through a write can never leave a truncated blob under the final filename.
+//
+// The blob's media type is recorded alongside it so it can be served with the
+// correct Content-Type. Concurrent saves of the same key are collapsed into a
+// single write.
+//
+// Existing blobs are left alone unless replace is set.
+func (s *OnDiskStorage) save(key blob.Key, mediaType string, replace bool, writeTemp func() (string, error)) error {
+	filename := s.path(key)
+
+	write := func() (any, error) {
+		if _, err := os.Stat(filename); err == nil && !replace {
+			s.logger.Debug(
+				"Already exists",
+				zap.String("filename", filename),
+				zap.Stringer("key", key),
+			)
+			return nil, nil
+		}
+
+		if err := os.MkdirAll(path.Dir(filename), 0766); err != nil {
+			return nil, fmt.Errorf("unable to create %s/: %w", path.Dir(filename), err)
+		}
+
+		tmp, err := writeTemp()
+		if tmp != "" {
+			defer s.removeTemp(tmp)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to write %s to a temporary file: %w", key, err)
+		}
+
 		s.logger.Debug(
-			"Already exists",
+			"Saving blob",
 			zap.String("filename", filename),
 			zap.Stringer("key", key),
 		)
 
-		return key, nil
+		// Note: The media type needs to be written first so anyone who can
+		// see the blob can also see its media type.
+		if err := os.WriteFile(s.mediaTypePath(key), []byte(mediaType), 0666); err != nil {
+			return nil, fmt.Errorf("unable to record the media type for %s: %w", key, err)
+		}
+
+		if err := os.Rename(tmp, filename); err != nil {
+			return nil, fmt.Errorf("unable to save to %s: %w", filename, err)
+		}
+
+		return nil, nil
 	}
 
-	s.logger.Debug(
-		"Saving blob",
-		zap.String("filename", filename),
-		zap.Stringer("key", key),
-		zap.Int("bytes", len(data)),
-	)
-	// FIXME: Should probably write to a temporary file and move to the final
-	// destination. We might also want to use singleflight so we don't write
-	// to the same file multiple times.
-	if err := os.WriteFile(filename, data, 0766); err != nil {
-		return blob.Key{}, fmt.Errorf("unable to save to %s: %w", filename, err)
+	if replace {
+		// Note: A replacement might have different contents, so it can't be
+		// collapsed into another write. The rename is atomic anyway.
+		_, err := write()
+		return err
 	}
 
-	return key, nil
+	_, err, _ := s.inflight.Do(key.String(), write)
+	return err
+}
+
+func (s *OnDiskStorage) removeTemp(tmp string) {
+	if err := os.Remove(tmp); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		s.logger.Warn("Unable to remove the temporary file", zap.String("path", tmp), zap.Error(err))
+	}
+}
+
+func (s *OnDiskStorage) Open(ctx context.Context, key blob.Key) (io.ReadCloser, error) {
+	filename := s.path(key)
+
+	f, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("unable to find %q: %w", filename, blob.ErrNotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to open %q: %w", filename, err)
+	}
+
+	return f, nil
+}
+
+func (s *OnDiskStorage) List(ctx context.Context, fn func(blob.Info) error) error {
+	// Note: WalkDir visits entries in lexical order and the shard directories
+	// are prefixes of the key, so blobs will be visited in order.
+	err := filepath.WalkDir(s.rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		key, err := blob.ParseKey(d.Name())
+		if err != nil {
+			// Temporary files, etc.
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			// The blob was deleted while we were iterating
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		return fn(blob.Info{
+			Key:          key,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+	})
+
+	if errors.Is(err, fs.ErrNotExist) {
+		// Nothing has been saved yet
+		return nil
+	}
+
+	return err
+}
+
+func (s *OnDiskStorage) Delete(ctx context.Context, key blob.Key) error {
+	filename := s.path(key)
+
+	err := os.Remove(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("unable to find %q: %w", filename, blob.ErrNotFound)
+	} else if err != nil {
+		return fmt.Errorf("unable to delete %q: %w", filename, err)
+	}
+
+	if err := os.Remove(s.mediaTypePath(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		s.logger.Warn("Unable to remove the media type", zap.Stringer("key", key), zap.Error(err))
+	}
+
+	s.logger.Debug("Deleted blob", zap.String("filename", filename), zap.Stringer("key", key))
+
+	return nil
+}
+
+// FromURL creates an OnDiskStorage from a "file://" URL.
+//
+// The URL's path is used as the root directory, and the following query
+// parameters are supported:
+//
+//   - secret - the secret used to sign links
+//   - listen - the address the built-in server listens on, or "none"
+//   - base-url - the public URL blobs are served under
+func FromURL(ctx context.Context, logger *zap.Logger, u *url.URL) (*OnDiskStorage, error) {
+	cfg, err := configFromURL(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithConfig(logger, cfg)
+}
+
+func configFromURL(u *url.URL) (Config, error) {
+	if u.Host != "" && u.Host != "localhost" {
+		return Config{}, fmt.Errorf("file URLs can't refer to another host (%q)", u.Host)
+	}
+	if u.Path == "" {
+		return Config{}, errors.New("no directory specified")
+	}
+
+	query := u.Query()
+
+	return Config{
+		RootDir:    u.Path,
+		Secret:     []byte(query.Get("secret")),
+		ListenAddr: query.Get("listen"),
+		BaseURL:    query.Get("base-url"),
+	}, nil
 }
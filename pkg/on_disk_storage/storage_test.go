@@ -4,9 +4,13 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
 )
@@ -30,3 +34,252 @@ func TestStoreBlobAndReadItBack(t *testing.T) {
 
 	assert.Equal(t, blob, string(body))
 }
+
+func TestRejectUnsignedLinks(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	storage, err := New(logger, t.TempDir())
+	assert.NoError(t, err)
+	defer storage.Close()
+	key, err := storage.Store(context.Background(), []byte("Hello, World"))
+	assert.NoError(t, err)
+	link, err := storage.Link(context.Background(), key, 1*time.Hour)
+	assert.NoError(t, err)
+
+	unsigned := *link
+	unsigned.RawQuery = ""
+
+	tampered := *link
+	query := tampered.Query()
+	query.Set("expires", "99999999999")
+	tampered.RawQuery = query.Encode()
+
+	expired, err := storage.Link(context.Background(), key, -1*time.Minute)
+	assert.NoError(t, err)
+
+	for name, u := range map[string]string{
+		"unsigned": unsigned.String(),
+		"tampered": tampered.String(),
+		"expired":  expired.String(),
+	} {
+		response, err := http.Get(u)
+		assert.NoError(t, err)
+		response.Body.Close()
+		assert.Equal(t, http.StatusForbidden, response.StatusCode, name)
+	}
+}
+
+func TestLinksSignedBySharedSecretAreAccepted(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	dir := t.TempDir()
+	cfg := Config{RootDir: dir, Secret: []byte("shared secret")}
+	first, err := NewWithConfig(logger, cfg)
+	assert.NoError(t, err)
+	defer first.Close()
+	second, err := NewWithConfig(logger, cfg)
+	assert.NoError(t, err)
+	defer second.Close()
+	key, err := first.Store(context.Background(), []byte("Hello, World"))
+	assert.NoError(t, err)
+	link, err := first.Link(context.Background(), key, 1*time.Hour)
+	assert.NoError(t, err)
+
+	// Send the request to the second server
+	link.Host = second.Addr().String()
+	response, err := http.Get(link.String())
+	assert.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestServeBlobsFromAnotherRouter(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	router := http.NewServeMux()
+	server := httptest.NewServer(router)
+	defer server.Close()
+	storage, err := NewWithConfig(logger, Config{
+		RootDir:    t.TempDir(),
+		ListenAddr: NoListen,
+		BaseURL:    server.URL + "/blobs",
+	})
+	assert.NoError(t, err)
+	defer storage.Close()
+	router.Handle("/blobs/", http.StripPrefix("/blobs", storage))
+	key, err := storage.Store(context.Background(), []byte("Hello, World"))
+	assert.NoError(t, err)
+
+	link, err := storage.Link(context.Background(), key, 1*time.Hour)
+	assert.NoError(t, err)
+	response, err := http.Get(link.String())
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+
+	assert.Nil(t, storage.Addr())
+	assert.Equal(t, "/blobs/"+key.String(), link.Path)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "Hello, World", string(body))
+}
+
+func TestDisablingTheServerRequiresABaseURL(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	_, err := NewWithConfig(logger, Config{RootDir: t.TempDir(), ListenAddr: NoListen})
+
+	assert.Error(t, err)
+}
+
+func TestServeAudioWithCachingAndRanges(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	storage, err := New(logger, t.TempDir())
+	assert.NoError(t, err)
+	defer storage.Close()
+	audio := "ID3\x04\x00 pretend this is an mp3"
+	key, err := storage.StoreReader(context.Background(), strings.NewReader(audio))
+	assert.NoError(t, err)
+	link, err := storage.Link(context.Background(), key, 1*time.Hour)
+	assert.NoError(t, err)
+
+	// A normal request
+	response, err := http.Get(link.String())
+	assert.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "audio/mpeg", response.Header.Get("Content-Type"))
+	assert.Equal(t, `"`+key.String()+`"`, response.Header.Get("ETag"))
+	assert.Contains(t, response.Header.Get("Cache-Control"), "immutable")
+
+	// Seeking part-way through
+	req, err := http.NewRequest(http.MethodGet, link.String(), nil)
+	assert.NoError(t, err)
+	req.Header.Set("Range", "bytes=6-")
+	response, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusPartialContent, response.StatusCode)
+	assert.Equal(t, audio[6:], string(body))
+
+	// Revalidating a cached copy
+	req, err = http.NewRequest(http.MethodGet, link.String(), nil)
+	assert.NoError(t, err)
+	req.Header.Set("If-None-Match", `"`+key.String()+`"`)
+	response, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusNotModified, response.StatusCode)
+
+	// The media type goes away with the blob
+	assert.NoError(t, storage.Delete(context.Background(), key))
+	assert.NoFileExists(t, storage.mediaTypePath(key))
+}
+
+func TestStoreAtReplacesExistingBlobs(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	storage, err := New(logger, t.TempDir())
+	assert.NoError(t, err)
+	defer storage.Close()
+	ctx := context.Background()
+	key := blob.KeyForBytes([]byte("plaintext"))
+
+	assert.NoError(t, storage.StoreAt(ctx, key, strings.NewReader("first")))
+	assert.NoError(t, storage.StoreAt(ctx, key, strings.NewReader("second")))
+
+	r, err := storage.Open(ctx, key)
+	assert.NoError(t, err)
+	defer r.Close()
+	body, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "second", string(body))
+}
+
+func TestStreamBlobInAndOut(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	storage, err := New(logger, t.TempDir())
+	assert.NoError(t, err)
+	defer storage.Close()
+	content := "Hello, World"
+
+	key, err := storage.StoreReader(context.Background(), strings.NewReader(content))
+	assert.NoError(t, err)
+	r, err := storage.Open(context.Background(), key)
+	assert.NoError(t, err)
+	defer r.Close()
+	body, err := io.ReadAll(r)
+	assert.NoError(t, err)
+
+	assert.Equal(t, blob.KeyForBytes([]byte(content)), key)
+	assert.Equal(t, content, string(body))
+}
+
+func TestOpenMissingBlob(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	storage, err := New(logger, t.TempDir())
+	assert.NoError(t, err)
+	defer storage.Close()
+
+	_, err = storage.Open(context.Background(), blob.KeyForBytes([]byte("missing")))
+
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestListAndDeleteBlobs(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	storage, err := New(logger, t.TempDir())
+	assert.NoError(t, err)
+	defer storage.Close()
+	ctx := context.Background()
+	first, err := storage.Store(ctx, []byte("first"))
+	assert.NoError(t, err)
+	second, err := storage.Store(ctx, []byte("second"))
+	assert.NoError(t, err)
+
+	var keys []blob.Key
+	err = storage.List(ctx, func(info blob.Info) error {
+		keys = append(keys, info.Key)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []blob.Key{first, second}, keys)
+	assert.True(t, keys[0].String() < keys[1].String())
+
+	assert.NoError(t, storage.Delete(ctx, first))
+	assert.ErrorIs(t, storage.Delete(ctx, first), blob.ErrNotFound)
+	_, err = storage.Open(ctx, first)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestConfigFromURL(t *testing.T) {
+	inputs := map[string]Config{
+		"file:///var/blobs":                 {RootDir: "/var/blobs", Secret: []byte{}},
+		"file://localhost/var/blobs":        {RootDir: "/var/blobs", Secret: []byte{}},
+		"file:///var/blobs?secret=password": {RootDir: "/var/blobs", Secret: []byte("password")},
+		"file:///var/blobs?listen=none&base-url=https://audio.example/blobs": {
+			RootDir:    "/var/blobs",
+			Secret:     []byte{},
+			ListenAddr: NoListen,
+			BaseURL:    "https://audio.example/blobs",
+		},
+	}
+
+	for input, expected := range inputs {
+		u, err := url.Parse(input)
+		assert.NoError(t, err)
+
+		got, err := configFromURL(u)
+
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, got, input)
+	}
+
+	for _, input := range []string{"file://example.com/var/blobs", "file://"} {
+		u, err := url.Parse(input)
+		assert.NoError(t, err)
+
+		_, err = configFromURL(u)
+
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,128 @@
+package radiochatter
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// DefaultStallTimeout is how long a stream can go without receiving any audio
+// before it counts as an outage.
+const DefaultStallTimeout = 30 * time.Second
+
+// outageTracker records a stream's outages as recording stops and resumes.
+type outageTracker struct {
+	logger   *zap.Logger
+	db       *gorm.DB
+	streamID uint
+
+	mu   sync.Mutex
+	open *StreamOutage
+}
+
+// newOutageTracker creates an outageTracker, picking up any outage that was
+// still open when the downloader last stopped.
+func newOutageTracker(logger *zap.Logger, db *gorm.DB, streamID uint) *outageTracker {
+	t := &outageTracker{logger: logger, db: db, streamID: streamID}
+
+	var open StreamOutage
+	err := db.Where("stream_id = ? AND ended_at IS NULL", streamID).Order("started_at DESC").First(&open).Error
+	if err == nil {
+		t.open = &open
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		// Note: The worst that can happen is we record the same outage twice
+		logger.Warn("Unable to check for an existing outage", zap.Error(err))
+	}
+
+	return t
+}
+
+// Stopped starts a new outage, unless one is already in progress.
+func (t *outageTracker) Stopped(at time.Time, reason string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.open != nil {
+		return
+	}
+
+	outage := StreamOutage{StreamID: t.streamID, StartedAt: at.UTC(), Reason: reason}
+	if err := t.db.Create(&outage).Error; err != nil {
+		t.logger.Warn("Unable to record the outage", zap.Any("outage", outage), zap.Error(err))
+		return
+	}
+
+	t.logger.Info("Outage started", zap.Time("at", at), zap.String("reason", reason))
+	t.open = &outage
+}
+
+// Resumed ends the current outage, if there is one.
+func (t *outageTracker) Resumed(at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.open == nil {
+		return
+	}
+
+	ended := at.UTC()
+	if err := t.db.Model(t.open).Update("ended_at", ended).Error; err != nil {
+		t.logger.Warn("Unable to end the outage", zap.Any("outage", t.open), zap.Error(err))
+		return
+	}
+
+	t.logger.Info("Outage ended", zap.Duration("duration", ended.Sub(t.open.StartedAt)))
+	t.open = nil
+}
+
+// Coverage calculates the fraction of the time between from and to that a
+// stream was being recorded, given the outages that overlap that period.
+// Outages which haven't ended yet are assumed to go until now.
+func Coverage(outages []StreamOutage, from, to, now time.Time) float64 {
+	if !to.After(from) {
+		return 1
+	}
+
+	downtime := Downtime(outages, from, to, now)
+
+	return 1 - float64(downtime)/float64(to.Sub(from))
+}
+
+// Downtime calculates how long a stream wasn't being recorded between from and
+// to. Overlapping outages are only counted once.
+func Downtime(outages []StreamOutage, from, to, now time.Time) time.Duration {
+	var downtime time.Duration
+	// The end of the last outage we counted, so overlaps are skipped
+	counted := from
+
+	for _, outage := range sortedOutages(outages) {
+		start := outage.StartedAt
+		end := now
+		if outage.EndedAt != nil {
+			end = *outage.EndedAt
+		}
+
+		if start.Before(counted) {
+			start = counted
+		}
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			downtime += end.Sub(start)
+			counted = end
+		}
+	}
+
+	return downtime
+}
+
+func sortedOutages(outages []StreamOutage) []StreamOutage {
+	sorted := append([]StreamOutage(nil), outages...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].StartedAt.Before(sorted[j].StartedAt) })
+	return sorted
+}
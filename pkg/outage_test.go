@@ -0,0 +1,63 @@
+package radiochatter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestOutagesAreOpenedOnceAndClosedWhenRecordingResumes(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	stream := Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, db.Save(&stream).Error)
+	tracker := newOutageTracker(logger, db, stream.ID)
+
+	tracker.Stopped(timestamp(1), "connection reset by peer")
+	// Retrying doesn't start a new outage
+	tracker.Stopped(timestamp(2), "404 Not Found")
+	// The downloader gets restarted while the stream is still down
+	tracker = newOutageTracker(logger, db, stream.ID)
+	tracker.Stopped(timestamp(3), "recording was stopped")
+	tracker.Resumed(timestamp(4))
+	// Resuming twice is fine
+	tracker.Resumed(timestamp(5))
+	tracker.Stopped(timestamp(6), "no audio received")
+
+	var outages []StreamOutage
+	assert.NoError(t, db.Where("stream_id = ?", stream.ID).Order("started_at").Find(&outages).Error)
+	if assert.Len(t, outages, 2) {
+		assert.Equal(t, timestamp(1), outages[0].StartedAt.UTC())
+		assert.Equal(t, timestamp(4), outages[0].EndedAt.UTC())
+		assert.Equal(t, "connection reset by peer", outages[0].Reason)
+		assert.Equal(t, timestamp(6), outages[1].StartedAt.UTC())
+		assert.Nil(t, outages[1].EndedAt)
+		assert.Equal(t, "no audio received", outages[1].Reason)
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	ended := func(minutes int) *time.Time {
+		t := at(minutes)
+		return &t
+	}
+	outages := []StreamOutage{
+		// Starts before the period we care about
+		{StartedAt: at(-10), EndedAt: ended(10)},
+		{StartedAt: at(30), EndedAt: ended(40)},
+		// Overlaps with the previous one
+		{StartedAt: at(35), EndedAt: ended(45)},
+		// Still going
+		{StartedAt: at(90)},
+	}
+
+	assert.Equal(t, 30*time.Minute, Downtime(outages, at(0), at(100), at(95)))
+	assert.InDelta(t, 0.7, Coverage(outages, at(0), at(100), at(95)), 1e-9)
+	assert.Equal(t, 1.0, Coverage(outages, at(50), at(80), at(95)))
+	assert.Equal(t, 1.0, Coverage(nil, at(0), at(0), at(95)))
+}
@@ -10,17 +10,182 @@ import (
 	"path"
 	"regexp"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 const defaultGracefulShutdown = 10 * time.Second
 const ffmpegCommand = "ffmpeg"
 const FeedUrl = "https://broadcastify.cdnstream1.com/39131"
 
-// ChunkLength dictates the size of each chunk generated by ffmpeg.
-const ChunkLength = 60 * time.Second
+// DefaultChunkLength is the size of each chunk generated by ffmpeg when
+// neither the stream nor the deployment overrides it.
+const DefaultChunkLength = 60 * time.Second
+
+// The silence detection settings used when a stream doesn't override them.
+const (
+	DefaultNoiseThreshold = -30.0
+	DefaultMinSilence     = 1 * time.Second
+	DefaultPadding        = 100 * time.Millisecond
+)
+
+// SilenceDetector is the method used to find the silence between
+// transmissions.
+type SilenceDetector string
+
+const (
+	// Use ffmpeg's silencedetect filter, which only looks at the volume.
+	DetectSilenceFFmpeg SilenceDetector = "silencedetect"
+	// Have ffmpeg send raw audio to a voice activity detector written in Go,
+	// which also rejects things like carrier noise and squelch tails.
+	DetectSilenceVAD SilenceDetector = "vad"
+)
+
+func ParseSilenceDetector(raw string) (SilenceDetector, error) {
+	switch detector := SilenceDetector(raw); detector {
+	case "", DetectSilenceFFmpeg:
+		return DetectSilenceFFmpeg, nil
+	case DetectSilenceVAD:
+		return detector, nil
+	default:
+		return "", fmt.Errorf("unknown silence detector, %q, expected one of %s, %s", raw, DetectSilenceFFmpeg, DetectSilenceVAD)
+	}
+}
+
+// AudioFilter is a preset chain of ffmpeg filters used to clean up a stream's
+// audio (removing hum and hiss, evening out the volume, etc.) before looking
+// for transmissions.
+type AudioFilter string
+
+const (
+	// Leave the audio alone.
+	AudioFilterNone AudioFilter = "none"
+	// Remove rumble and even out the volume.
+	AudioFilterLight AudioFilter = "light"
+	// Also remove steady background noise. A good choice for most feeds.
+	AudioFilterVoice AudioFilter = "voice"
+	// Aggressively remove hum and hiss from feeds that are hard to listen to.
+	AudioFilterNoisy AudioFilter = "noisy"
+)
+
+// Every preset finishes by resampling to 16 kHz mono, which is all speech
+// needs and what Whisper expects. It also undoes loudnorm upsampling to
+// 192 kHz.
+const (
+	loudnormFilter = "loudnorm=I=-16:TP=-1.5:LRA=11"
+	resampleFilter = "aformat=sample_rates=16000:channel_layouts=mono"
+)
+
+var audioFilterPresets = map[AudioFilter][]string{
+	AudioFilterLight: {"highpass=f=100", loudnormFilter, resampleFilter},
+	AudioFilterVoice: {"highpass=f=200", "afftdn=nr=12:nf=-50", loudnormFilter, resampleFilter},
+	AudioFilterNoisy: {"highpass=f=300", "afftdn=nr=24:nf=-40", loudnormFilter, resampleFilter},
+}
+
+func ParseAudioFilter(raw string) (AudioFilter, error) {
+	switch filter := AudioFilter(raw); filter {
+	case "", AudioFilterNone:
+		return AudioFilterNone, nil
+	case AudioFilterLight, AudioFilterVoice, AudioFilterNoisy:
+		return filter, nil
+	default:
+		return "", fmt.Errorf(
+			"unknown audio filter, %q, expected one of %s, %s, %s, %s",
+			raw, AudioFilterNone, AudioFilterLight, AudioFilterVoice, AudioFilterNoisy,
+		)
+	}
+}
+
+// chain gets the filters as an ffmpeg filter chain, or an empty string if the
+// audio should be left alone.
+func (f AudioFilter) chain() string {
+	return strings.Join(audioFilterPresets[f], ",")
+}
+
+// PreprocessOptions controls how audio is split into chunks and transmissions.
+type PreprocessOptions struct {
+	// How long each chunk generated by ffmpeg should be.
+	ChunkLength time.Duration
+	// The format chunks are saved in.
+	ChunkCodec AudioCodec
+	// The format transmissions are saved in.
+	TransmissionCodec AudioCodec
+	// How the audio is cleaned up before looking for silence.
+	AudioFilter AudioFilter
+	// Also write a copy of each chunk before the audio filter is applied.
+	KeepRawChunks bool
+	// How silence is detected.
+	SilenceDetector SilenceDetector
+	// Audio quieter than this (in dB) is treated as silence.
+	NoiseThreshold float64
+	// How long the audio needs to be quiet before it counts as silence.
+	MinSilence time.Duration
+	// How much audio to keep either side of a transmission so it doesn't
+	// sound like it's been cut off.
+	Padding time.Duration
+}
+
+func DefaultPreprocessOptions() PreprocessOptions {
+	return PreprocessOptions{
+		ChunkLength:       DefaultChunkLength,
+		ChunkCodec:        CodecMP3,
+		TransmissionCodec: CodecMP3,
+		AudioFilter:       AudioFilterNone,
+		SilenceDetector:   DetectSilenceFFmpeg,
+		NoiseThreshold:    DefaultNoiseThreshold,
+		MinSilence:        DefaultMinSilence,
+		Padding:           DefaultPadding,
+	}
+}
+
+// Validate checks that the options make sense.
+func (o PreprocessOptions) Validate() error {
+	if o.ChunkLength < time.Second {
+		return fmt.Errorf("chunks must be at least 1s long, found %s", o.ChunkLength)
+	}
+	if _, err := ParseAudioCodec(string(o.ChunkCodec)); err != nil {
+		return err
+	}
+	if _, err := ParseAudioCodec(string(o.TransmissionCodec)); err != nil {
+		return err
+	}
+	if _, err := ParseAudioFilter(string(o.AudioFilter)); err != nil {
+		return err
+	}
+	if _, err := ParseSilenceDetector(string(o.SilenceDetector)); err != nil {
+		return err
+	}
+	if o.NoiseThreshold >= 0 {
+		return fmt.Errorf("the noise threshold must be below 0dB, found %vdB", o.NoiseThreshold)
+	}
+	if o.MinSilence <= 0 {
+		return fmt.Errorf("the minimum silence duration must be positive, found %s", o.MinSilence)
+	}
+	if o.Padding < 0 {
+		return fmt.Errorf("the padding can't be negative, found %s", o.Padding)
+	}
+
+	return nil
+}
+
+// writesRawChunks checks whether ffmpeg needs to write an untouched copy of
+// each chunk.
+func (o PreprocessOptions) writesRawChunks() bool {
+	return o.KeepRawChunks && o.AudioFilter.chain() != ""
+}
+
+// silenceDetectFilter gets the ffmpeg filter used to detect silence.
+func (o PreprocessOptions) silenceDetectFilter() string {
+	return fmt.Sprintf(
+		"silencedetect=noise=%sdB:d=%s",
+		strconv.FormatFloat(o.NoiseThreshold, 'f', -1, 64),
+		strconv.FormatFloat(o.MinSilence.Seconds(), 'f', -1, 64),
+	)
+}
 
 // messagePattern will match a string like "[silencedetect @ 0x600000c583c0] ..."
 var messagePattern = regexp.MustCompile(`^\[(\S+) @ [\d\w]+\]\s*(.*)$`)
@@ -31,8 +196,8 @@ var openingFilePattern = regexp.MustCompile(`^Opening '([^']+)' for writing$`)
 var silenceStartPattern = regexp.MustCompile(`silence_start: (\d+(?:\.\d+)?)`)
 var silenceEndPattern = regexp.MustCompile(`silence_end: (\d+(?:\.\d+)?) \| silence_duration: (\d+(?:\.\d+)?)`)
 
-// Preprocess will take some ffmpeg input and split it into 60-second chunks,
-// saved in the provided directory. The caller will be notified when certain
+// Preprocess will take some ffmpeg input and split it into chunks, saved in
+// the provided directory. The caller will be notified when certain
 // events occur via the preprocessing callbacks.
 //
 // The input may be a URL or a filename.
@@ -41,32 +206,60 @@ var silenceEndPattern = regexp.MustCompile(`silence_end: (\d+(?:\.\d+)?) \| sile
 // etc.) when the context is cancelled. However, if the graceful shutdown
 // doesn't complete within a reasonable amount of time it will be forcefully
 // killed.
-func Preprocess(ctx context.Context, logger *zap.Logger, input string, outputDir string, cb PreprocessingCallbacks) error {
-	args := []string{
-		"-i", input,
-		// Use a filter to detect silence and print its timestamps
-		"-af", "silencedetect=noise=-30dB:d=1",
-		// Split into 60-second chunks
-		"-f", "segment", "-segment_time", strconv.Itoa(int(ChunkLength) / int(time.Second)),
-		// Clean up stderr so it's easier to parse
-		"-hide_banner", "-nostdin", "-nostats",
-		// the output path
-		path.Join(outputDir, "chunk_%d.mp3"),
-	}
-
-	cmd := exec.CommandContext(ctx, ffmpegCommand, args...)
+func Preprocess(ctx context.Context, logger *zap.Logger, input string, outputDir string, opts PreprocessOptions, cb PreprocessingCallbacks) error {
+	cmd := exec.CommandContext(ctx, ffmpegCommand, preprocessArgs(input, outputDir, opts)...)
+	// Make sure strftime uses UTC
+	cmd.Env = append(os.Environ(), "TZ=UTC")
 
 	stderr, err := cmd.StderrPipe()
 	if err != nil {
 		return err
 	}
 
-	parsingFinished := make(chan error, 1)
-	go func() {
-		defer close(parsingFinished)
-		parsingFinished <- parseStderr(logger, stderr, cb)
+	// Note: cmd.StdoutPipe() gets closed as soon as ffmpeg exits, which could
+	// throw away the last few lines of output, so the other parsers use our
+	// own pipes and read until EOF instead.
+	var writers []*os.File
+	pipe := func() (*os.File, *os.File, error) {
+		r, w, err := os.Pipe()
+		if err != nil {
+			return nil, nil, err
+		}
+		writers = append(writers, w)
+		return r, w, nil
+	}
+	defer func() {
+		for _, w := range writers {
+			w.Close()
+		}
 	}()
 
+	progress, progressWriter, err := pipe()
+	if err != nil {
+		return err
+	}
+	defer progress.Close()
+	segmentList, segmentListWriter, err := pipe()
+	if err != nil {
+		return err
+	}
+	defer segmentList.Close()
+	// Note: The extra files become file descriptors 3, 4, and so on
+	cmd.ExtraFiles = []*os.File{progressWriter, segmentListWriter}
+
+	var stdout *os.File
+	if opts.SilenceDetector == DetectSilenceVAD {
+		r, w, err := pipe()
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		stdout = r
+		cmd.Stdout = w
+	}
+
+	defer cb.onFinished()
+
 	// Note: We want to give ffmpeg a chance to flush its buffers and shut down
 	// gracefully, so when the context is cancelled we'll first send a SIGINT,
 	// then wait a bit to let the command exit. If it doesn't exit in time, it
@@ -76,10 +269,59 @@ func Preprocess(ctx context.Context, logger *zap.Logger, input string, outputDir
 		return cmd.Process.Signal(os.Interrupt)
 	}
 
+	// Note: The parsers are only started once ffmpeg is running, so every
+	// early return happens before any callbacks could be triggered
+	started := time.Now()
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("unable to start %q: %w", cmd, err)
 	}
 
+	// ffmpeg has its own copies of the write ends now
+	for _, w := range writers {
+		w.Close()
+	}
+
+	var parsers errgroup.Group
+	// We can only say we're finished once all the parsers are done
+	parserCallbacks := cb
+	parserCallbacks.Finished = nil
+
+	progressCallbacks := parserCallbacks
+	parsers.Go(func() error { return parseProgress(progress, started, time.Now, progressCallbacks) })
+
+	// Note: The remaining parsers all run on different goroutines, but
+	// callbacks like the archiver's aren't goroutine-safe.
+	parserCallbacks = parserCallbacks.synchronized()
+
+	gate := newSegmentGate()
+	segmentListCallbacks := parserCallbacks
+	segmentListCallbacks.SegmentComplete = func(seg Segment) {
+		parserCallbacks.onSegmentComplete(seg)
+		gate.SegmentCompleted()
+	}
+	parsers.Go(func() error {
+		defer gate.Close()
+		return parseSegmentList(segmentList, outputDir, segmentListCallbacks)
+	})
+
+	if stdout != nil {
+		vad := opts.vad()
+		vadCallbacks := parserCallbacks
+		parsers.Go(func() error { return vad.Detect(stdout, vadCallbacks) })
+	}
+
+	stderrCallbacks := parserCallbacks
+	opened := 0
+	stderrCallbacks.StartWriting = func(path string) {
+		// Make sure the previous chunk's segment list entry arrives first
+		if opened > 0 && !gate.Wait(opened, segmentListTimeout) {
+			logger.Warn("Timed out waiting for the segment list", zap.String("path", path))
+		}
+		opened++
+		parserCallbacks.onStartWriting(path)
+	}
+	parsers.Go(func() error { return parseStderr(logger, stderr, stderrCallbacks) })
+
 	logger.Debug(
 		"ffmpeg started",
 		zap.Stringer("cmd", cmd),
@@ -101,7 +343,7 @@ func Preprocess(ctx context.Context, logger *zap.Logger, input string, outputDir
 	// Note: we want to make sure parsing has finished and no more callbacks are
 	// triggered before returning from this function. That way we don't end
 	// up with any dangling goroutines and everything is deterministic.
-	parsingError := <-parsingFinished
+	parsingError := parsers.Wait()
 
 	// There are two possible sources of errors, 1) parsing could have ran into
 	// problems (e.g. invalid UTF-8), and 2) the process could have exited with
@@ -114,6 +356,80 @@ func Preprocess(ctx context.Context, logger *zap.Logger, input string, outputDir
 	}
 }
 
+// preprocessArgs gets the arguments ffmpeg is run with.
+//
+// The audio filter is applied to the whole input in a single pass, rather than
+// to each chunk, so filters like loudnorm and afftdn don't start from scratch
+// at every chunk boundary.
+func preprocessArgs(input string, outputDir string, opts PreprocessOptions) []string {
+	cleanup := opts.AudioFilter.chain()
+	vad := opts.SilenceDetector == DetectSilenceVAD
+	keepRaw := opts.writesRawChunks()
+
+	args := []string{
+		"-i", input,
+		// Clean up stderr so it's easier to parse
+		"-hide_banner", "-nostdin", "-nostats",
+		// Report progress as key=value pairs on file descriptor 3
+		"-progress", "pipe:3",
+	}
+
+	switch {
+	case cleanup != "":
+		graph := "[0:a]"
+		if keepRaw {
+			// Keep an untouched copy of the audio
+			graph += "asplit=2[raw][in];[in]"
+		}
+		graph += cleanup
+		if vad {
+			graph += ",asplit=2[chunks][vad]"
+		} else {
+			// Note: silencedetect passes the audio through unchanged
+			graph += "," + opts.silenceDetectFilter() + "[chunks]"
+		}
+		args = append(args, "-filter_complex", graph, "-map", "[chunks]")
+	case !vad:
+		// Use a filter to detect silence and print its timestamps
+		args = append(args, "-af", opts.silenceDetectFilter())
+	}
+
+	// Split into fixed-length chunks
+	segment := []string{"-f", "segment", "-segment_time", strconv.FormatFloat(opts.ChunkLength.Seconds(), 'f', -1, 64)}
+	extension := "." + opts.ChunkCodec.Extension()
+
+	args = append(args, segment...)
+	// Write each chunk's start and end times to file descriptor 4
+	args = append(args, "-segment_list", "pipe:4", "-segment_list_type", "csv")
+	args = append(args, opts.ChunkCodec.encoderArgs()...)
+	if isLiveInput(input) {
+		// Name chunks after the wall-clock time they were started. Note that
+		// files are read much faster than real time, so several of their
+		// chunks could start within the same second and clobber each other.
+		args = append(args, "-strftime", "1", path.Join(outputDir, chunkTimestampPattern+extension))
+	} else {
+		args = append(args, path.Join(outputDir, "chunk_%d"+extension))
+	}
+
+	if keepRaw {
+		args = append(args, "-map", "[raw]")
+		args = append(args, segment...)
+		args = append(args, opts.ChunkCodec.encoderArgs()...)
+		args = append(args, path.Join(outputDir, rawChunkPrefix+"chunk_%d"+extension))
+	}
+
+	if vad {
+		// Send a second copy of the audio to stdout as raw PCM so we can
+		// look for speech ourselves
+		if cleanup != "" {
+			args = append(args, "-map", "[vad]")
+		}
+		args = append(args, "-ac", "1", "-ar", strconv.Itoa(vadSampleRate), "-f", "s16le", "pipe:1")
+	}
+
+	return args
+}
+
 // parseStderr reads the output from ffmpeg and triggers callbacks to notify
 // the caller when certain events occur.
 func parseStderr(logger *zap.Logger, stderr io.Reader, cb PreprocessingCallbacks) error {
@@ -126,9 +442,16 @@ func parseStderr(logger *zap.Logger, stderr io.Reader, cb PreprocessingCallbacks
 
 	defer cb.onFinished()
 
+	var header inputHeader
+	defer header.finish(cb.onInputInfo)
+
 	for buffer.Scan() {
 		line := buffer.Text()
 
+		if header.parse(line, cb.onInputInfo) {
+			continue
+		}
+
 		match := messagePattern.FindStringSubmatch(line)
 		if match != nil {
 			msg := ComponentMessage{
@@ -158,6 +481,10 @@ func (s *state) process(msg ComponentMessage) {
 		matches := openingFilePattern.FindStringSubmatch(msg.Payload)
 		if matches != nil {
 			path := matches[1]
+			if strings.HasPrefix(path, "pipe:") || isRawChunk(path) {
+				// The segment list or the untouched copy of a chunk
+				return
+			}
 			if !s.running {
 				s.cb.onDownloadStarted()
 				s.running = true
@@ -196,7 +523,7 @@ func (s *state) process(msg ComponentMessage) {
 			return
 		}
 
-	case "out#0/segment":
+	case "out#0/segment", "out#1/segment", "out#1/s16le", "out#2/s16le":
 		// End of input
 		return
 	}
@@ -230,6 +557,14 @@ type PreprocessingCallbacks struct {
 	//
 	// The durations are relative to the start of the input.
 	SilenceEnd func(t time.Duration, duration time.Duration)
+	// Ffmpeg has finished writing a chunk. This is always triggered before
+	// StartWriting is called for the next chunk.
+	SegmentComplete func(seg Segment)
+	// Ffmpeg has read the input's header, including its ICY metadata.
+	InputInfo func(info InputInfo)
+	// Ffmpeg reported how far it has got. This normally happens every half
+	// second.
+	Progress func(p Progress)
 	// An unknown message type was encountered.
 	UnknownMessage func(msg ComponentMessage)
 	// Received a line on stderr that wasn't part of a message.
@@ -238,6 +573,60 @@ type PreprocessingCallbacks struct {
 	Finished func()
 }
 
+// synchronized makes sure only one callback is running at a time.
+func (c PreprocessingCallbacks) synchronized() PreprocessingCallbacks {
+	var mu sync.Mutex
+
+	return PreprocessingCallbacks{
+		DownloadStarted:     locked0(&mu, c.DownloadStarted),
+		StartWriting:        locked1(&mu, c.StartWriting),
+		SilenceStart:        locked1(&mu, c.SilenceStart),
+		SilenceEnd:          locked2(&mu, c.SilenceEnd),
+		SegmentComplete:     locked1(&mu, c.SegmentComplete),
+		InputInfo:           locked1(&mu, c.InputInfo),
+		Progress:            locked1(&mu, c.Progress),
+		UnknownMessage:      locked1(&mu, c.UnknownMessage),
+		UninterpretedStderr: locked1(&mu, c.UninterpretedStderr),
+		Finished:            locked0(&mu, c.Finished),
+	}
+}
+
+func locked0(mu *sync.Mutex, f func()) func() {
+	if f == nil {
+		return nil
+	}
+
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+		f()
+	}
+}
+
+func locked1[T any](mu *sync.Mutex, f func(T)) func(T) {
+	if f == nil {
+		return nil
+	}
+
+	return func(arg T) {
+		mu.Lock()
+		defer mu.Unlock()
+		f(arg)
+	}
+}
+
+func locked2[T, U any](mu *sync.Mutex, f func(T, U)) func(T, U) {
+	if f == nil {
+		return nil
+	}
+
+	return func(first T, second U) {
+		mu.Lock()
+		defer mu.Unlock()
+		f(first, second)
+	}
+}
+
 func (c *PreprocessingCallbacks) onDownloadStarted() {
 	if c.DownloadStarted != nil {
 		c.DownloadStarted()
@@ -262,6 +651,24 @@ func (c *PreprocessingCallbacks) onSilenceEnd(t time.Duration, duration time.Dur
 	}
 }
 
+func (c *PreprocessingCallbacks) onSegmentComplete(seg Segment) {
+	if c.SegmentComplete != nil {
+		c.SegmentComplete(seg)
+	}
+}
+
+func (c *PreprocessingCallbacks) onInputInfo(info InputInfo) {
+	if c.InputInfo != nil {
+		c.InputInfo(info)
+	}
+}
+
+func (c *PreprocessingCallbacks) onProgress(p Progress) {
+	if c.Progress != nil {
+		c.Progress(p)
+	}
+}
+
 func (c *PreprocessingCallbacks) onUnknownMessage(msg ComponentMessage) {
 	if c.UnknownMessage != nil {
 		c.UnknownMessage(msg)
@@ -51,6 +51,180 @@ func TestParseStderr(t *testing.T) {
 	assert.Empty(t, cb.unknown)
 }
 
+func TestParseInputHeader(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	reader := strings.NewReader(stderr)
+	var infos []InputInfo
+	var uninterpreted []string
+	cb := PreprocessingCallbacks{
+		InputInfo:           func(info InputInfo) { infos = append(infos, info) },
+		UninterpretedStderr: func(line string) { uninterpreted = append(uninterpreted, line) },
+	}
+
+	err := parseStderr(logger, reader, cb)
+
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]InputInfo{
+			{
+				Format:     "mp3",
+				Bitrate:    8,
+				Codec:      "mp3",
+				SampleRate: 8000,
+				Channels:   "mono",
+				Metadata: map[string]string{
+					"icy-genre": "Public Safety",
+					"icy-name":  "Western Australia Fire Dispatch - DFES",
+					"icy-pub":   "0",
+				},
+			},
+		},
+		infos,
+	)
+	assert.Equal(t, "Stream mapping:", uninterpreted[0])
+}
+
+func TestParseInputHeaderWithMultipleStreams(t *testing.T) {
+	var header inputHeader
+	var infos []InputInfo
+	lines := []string{
+		"Input #0, ogg, from 'http://example.com/stream.ogg':",
+		"  Duration: N/A, start: 0.000000, bitrate: N/A",
+		"  Stream #0:0(eng): Audio: vorbis, 44100 Hz, stereo, fltp, 112 kb/s",
+		"    Metadata:",
+		"      ENCODER         : libvorbis",
+		"  Stream #0:1: Audio: opus, 48000 Hz, mono, fltp",
+	}
+
+	for _, line := range lines {
+		assert.True(t, header.parse(line, func(info InputInfo) { infos = append(infos, info) }))
+	}
+	assert.False(t, header.parse("Stream mapping:", func(info InputInfo) { infos = append(infos, info) }))
+
+	assert.Equal(
+		t,
+		[]InputInfo{
+			{
+				Format:     "ogg",
+				Bitrate:    112,
+				Codec:      "vorbis",
+				SampleRate: 44100,
+				Channels:   "stereo",
+				Metadata:   map[string]string{},
+			},
+		},
+		infos,
+	)
+}
+
+func TestSilenceDetectFilterUsesTheStreamSettings(t *testing.T) {
+	padding := time.Duration(0)
+	stream := Stream{NoiseThreshold: -45.5, MinSilence: 2500 * time.Millisecond, Padding: &padding}
+
+	opts := stream.PreprocessOptions()
+
+	assert.NoError(t, opts.Validate())
+	assert.Equal(t, "silencedetect=noise=-45.5dB:d=2.5", opts.silenceDetectFilter())
+	assert.Equal(t, time.Duration(0), opts.Padding)
+}
+
+func TestStreamsUseTheDefaultPreprocessOptions(t *testing.T) {
+	opts := Stream{}.PreprocessOptions()
+
+	assert.Equal(t, DefaultPreprocessOptions(), opts)
+	assert.Equal(t, "silencedetect=noise=-30dB:d=1", opts.silenceDetectFilter())
+}
+
+func TestStreamChunkLengthOverridesTheDeploymentDefault(t *testing.T) {
+	defaults := DefaultPreprocessOptions()
+	defaults.ChunkLength = 5 * time.Minute
+
+	assert.Equal(t, 5*time.Minute, Stream{}.PreprocessOptionsWithDefaults(defaults).ChunkLength)
+	assert.Equal(t, 30*time.Second, Stream{ChunkLength: 30 * time.Second}.PreprocessOptionsWithDefaults(defaults).ChunkLength)
+}
+
+func TestStreamsCanUseTheVAD(t *testing.T) {
+	detector, err := ParseSilenceDetector("")
+	assert.NoError(t, err)
+	assert.Equal(t, DetectSilenceFFmpeg, detector)
+
+	_, err = ParseSilenceDetector("magic")
+	assert.Error(t, err)
+
+	opts := Stream{SilenceDetector: DetectSilenceVAD}.PreprocessOptions()
+	assert.NoError(t, opts.Validate())
+	assert.Equal(t, DetectSilenceVAD, opts.SilenceDetector)
+}
+
+func TestAudioFilterPresets(t *testing.T) {
+	filter, err := ParseAudioFilter("")
+	assert.NoError(t, err)
+	assert.Equal(t, AudioFilterNone, filter)
+	assert.Equal(t, "", filter.chain())
+
+	_, err = ParseAudioFilter("magic")
+	assert.Error(t, err)
+
+	opts := Stream{AudioFilter: AudioFilterVoice}.PreprocessOptions()
+	assert.NoError(t, opts.Validate())
+	assert.Equal(
+		t,
+		"highpass=f=200,afftdn=nr=12:nf=-50,loudnorm=I=-16:TP=-1.5:LRA=11,aformat=sample_rates=16000:channel_layouts=mono",
+		opts.AudioFilter.chain(),
+	)
+}
+
+func TestAudioFiltersAreAppliedInASinglePass(t *testing.T) {
+	opts := Stream{AudioFilter: AudioFilterLight, SilenceDetector: DetectSilenceVAD, KeepRawChunks: true}.PreprocessOptions()
+
+	args := preprocessArgs("recording.mp3", "/tmp", opts)
+
+	assert.Equal(
+		t,
+		[]string{
+			"-i", "recording.mp3",
+			"-hide_banner", "-nostdin", "-nostats",
+			"-progress", "pipe:3",
+			"-filter_complex", "[0:a]asplit=2[raw][in];[in]highpass=f=100,loudnorm=I=-16:TP=-1.5:LRA=11,aformat=sample_rates=16000:channel_layouts=mono,asplit=2[chunks][vad]",
+			"-map", "[chunks]",
+			"-f", "segment", "-segment_time", "60",
+			"-segment_list", "pipe:4", "-segment_list_type", "csv",
+			"-c:a", "libmp3lame",
+			"/tmp/chunk_%d.mp3",
+			"-map", "[raw]",
+			"-f", "segment", "-segment_time", "60",
+			"-c:a", "libmp3lame",
+			"/tmp/raw_chunk_%d.mp3",
+			"-map", "[vad]",
+			"-ac", "1", "-ar", "16000", "-f", "s16le", "pipe:1",
+		},
+		args,
+	)
+}
+
+func TestRealRecordingWithAnAudioFilter(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	requires(t, ffmpegCommand)
+
+	ctx := testContext(t)
+	logger := zaptest.NewLogger(t)
+	recording := testRecording(t)
+	opts := DefaultPreprocessOptions()
+	opts.AudioFilter = AudioFilterVoice
+	var silences int
+	cb := PreprocessingCallbacks{
+		SilenceStart: func(t time.Duration) { silences++ },
+	}
+
+	err := Preprocess(ctx, logger, recording, t.TempDir(), opts, cb)
+
+	assert.NoError(t, err)
+	assert.NotZero(t, silences)
+}
+
 func TestRealRecording(t *testing.T) {
 	if testing.Short() {
 		t.SkipNow()
@@ -63,7 +237,7 @@ func TestRealRecording(t *testing.T) {
 	temp := t.TempDir()
 	var e eventData
 
-	err := Preprocess(ctx, logger, input, temp, e.Callbacks(t))
+	err := Preprocess(ctx, logger, input, temp, DefaultPreprocessOptions(), e.Callbacks(t))
 
 	assert.NoError(t, err)
 	assert.Equal(t,
@@ -108,6 +282,12 @@ func TestRealRecording(t *testing.T) {
 	)
 }
 
+// fastBackoff restarts almost immediately so tests don't have to wait, and
+// never resets the number of consecutive failures.
+func fastBackoff() Backoff {
+	return Backoff{Initial: time.Millisecond, Max: time.Millisecond, Multiplier: 2, ResetAfter: time.Hour}
+}
+
 func testContext(t *testing.T) context.Context {
 	t.Helper()
 	if deadline, ok := t.Deadline(); ok {
@@ -2,6 +2,7 @@ package radiochatter
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
@@ -12,30 +13,10 @@ import (
 
 type thunk = func() error
 
-func StartProcessing(
-	ctx context.Context,
-	logger *zap.Logger,
-	group *errgroup.Group,
-	stream Stream,
-	storage blob.Storage,
-	db *gorm.DB,
-) (cleanup func()) {
-	archiveOps := make(chan ArchiveOperation)
-
-	temp, cleanup := mkdtemp(logger)
-	logger.Debug(
-		"Saving clips to a temporary directory",
-		zap.String("path", temp),
-		zap.Uint("stream-id", stream.ID),
-		zap.String("stream-name", stream.DisplayName),
-	)
-
-	group.Go(preprocess(ctx, logger.Named("preprocess"), stream.Url, temp, archiveOps))
-	group.Go(archive(ctx, logger.Named("archive"), archiveOps, storage, db, stream))
-
-	return cleanup
-}
-
+// archive saves chunks and their transmissions as they are produced.
+//
+// This keeps going until the channel is closed, even if the context has been
+// cancelled, so the last chunk still gets saved when a stream is stopped.
 func archive(
 	ctx context.Context,
 	logger *zap.Logger,
@@ -43,64 +24,98 @@ func archive(
 	storage blob.Storage,
 	db *gorm.DB,
 	stream Stream,
+	opts PreprocessOptions,
 ) thunk {
 	return func() error {
+		ctx := context.WithoutCancel(ctx)
 		state := ArchiveState{
 			Logger:  logger,
 			Storage: storage,
 			DB:      db.WithContext(ctx),
 			Stream:  stream,
+			Options: opts,
 		}
 
-		for {
-			select {
-			case op, ok := <-archiveOps:
-				if !ok {
-					// Channel was closed. No more ops to execute.
-					return nil
-				}
-
-				logger.Debug("executing", zap.Reflect("op", op))
+		for op := range archiveOps {
+			logger.Debug("executing", zap.Reflect("op", op))
 
-				if err := op.Execute(ctx, state); err != nil {
-					return err
-				}
-			case <-ctx.Done():
-				return nil
+			if err := op.Execute(ctx, state); err != nil {
+				return err
 			}
 		}
+
+		return nil
 	}
 }
 
-func preprocess(
+// runEpoch records a stream until ffmpeg exits, something fails, or the
+// context is cancelled. Any DownloadStarted, InputInfo, and Progress observers
+// are notified alongside the archiver.
+//
+// Cancelling the context stops the stream gracefully, letting ffmpeg flush
+// its last chunk and waiting for it to be archived.
+func runEpoch(
 	ctx context.Context,
 	logger *zap.Logger,
-	url string,
+	stream Stream,
+	opts PreprocessOptions,
 	dir string,
-	archiveOps chan<- ArchiveOperation,
-) thunk {
-	return func() error {
+	storage blob.Storage,
+	db *gorm.DB,
+	observers PreprocessingCallbacks,
+) error {
+	// Note: ffmpeg restarts its chunk numbering from zero, so each epoch
+	// needs a fresh directory to avoid clobbering chunks from the previous
+	// one that haven't been archived yet.
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logger.Warn("Unable to remove the epoch's directory", zap.String("path", dir), zap.Error(err))
+		}
+	}()
+
+	// The group's context is only cancelled when something fails, while
+	// ffmpeg also gets told to stop when our caller cancels.
+	group, groupCtx := errgroup.WithContext(context.WithoutCancel(ctx))
+	ffmpegCtx, cancel := context.WithCancel(groupCtx)
+	defer cancel()
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
+	archiveOps := make(chan ArchiveOperation)
+
+	group.Go(recovered(func() error {
 		defer close(archiveOps)
 
-		cb := ArchiveCallbacks(ctx, archiveOps)
-		return Preprocess(ctx, logger, url, dir, cb)
-	}
+		cb := ArchiveCallbacks(groupCtx, archiveOps, opts)
+		cb.InputInfo = observers.InputInfo
+		cb.Progress = observers.Progress
+		if observers.DownloadStarted != nil {
+			archiverStarted := cb.DownloadStarted
+			cb.DownloadStarted = func() {
+				archiverStarted()
+				observers.DownloadStarted()
+			}
+		}
+		return Preprocess(ffmpegCtx, logger.Named("preprocess"), stream.Url, dir, opts, cb)
+	}))
+	group.Go(recovered(archive(groupCtx, logger.Named("archive"), archiveOps, storage, db, stream, opts)))
+
+	return group.Wait()
 }
 
-func mkdtemp(logger *zap.Logger) (string, context.CancelFunc) {
-	dir, err := os.MkdirTemp("", "radio-chatter-tmp")
-	if err != nil {
-		logger.Fatal("Unable to create a temporary directory", zap.Error(err))
-	}
-	cancel := func() {
-		if err := os.RemoveAll(dir); err != nil {
-			logger.Error(
-				"Unable to remove the temporary directory",
-				zap.String("temp", dir),
-				zap.Error(err),
-			)
-		}
-	}
+// recovered turns a panic into an error so one misbehaving stream can't take
+// down the others.
+func recovered(f thunk) thunk {
+	return func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
 
-	return dir, cancel
+		return f()
+	}
 }
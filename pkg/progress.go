@@ -0,0 +1,76 @@
+package radiochatter
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Progress is a snapshot of ffmpeg's progress, as reported by its -progress
+// output.
+type Progress struct {
+	// When the snapshot was received.
+	Timestamp time.Time
+	// The output bitrate in kbit/s, or zero if ffmpeg doesn't know it yet.
+	Bitrate float64
+	// How many bytes have been written so far.
+	TotalSize int64
+	// How much audio has been processed.
+	OutTime time.Duration
+	// How fast the audio is being processed, relative to real time. Live
+	// streams should hover around 1.0, while anything lower means we're
+	// falling behind.
+	Speed float64
+	// How far the processed audio lags behind the wall clock since ffmpeg
+	// was started.
+	Drift time.Duration
+	// This is the last snapshot because ffmpeg is exiting.
+	Finished bool
+}
+
+// parseProgress reads the key=value pairs written by ffmpeg's -progress flag,
+// triggering the Progress callback at the end of each block.
+func parseProgress(r io.Reader, started time.Time, now func() time.Time, cb PreprocessingCallbacks) error {
+	var progress Progress
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "bitrate":
+			if kbps, err := strconv.ParseFloat(strings.TrimSuffix(value, "kbits/s"), 64); err == nil {
+				progress.Bitrate = kbps
+			}
+		case "total_size":
+			if size, err := strconv.ParseInt(value, 10, 64); err == nil {
+				progress.TotalSize = size
+			}
+		case "out_time_us":
+			if us, err := strconv.ParseInt(value, 10, 64); err == nil {
+				progress.OutTime = time.Duration(us) * time.Microsecond
+			}
+		case "speed":
+			if speed, err := strconv.ParseFloat(strings.TrimSuffix(value, "x"), 64); err == nil {
+				progress.Speed = speed
+			}
+		case "progress":
+			progress.Timestamp = now()
+			progress.Drift = progress.Timestamp.Sub(started) - progress.OutTime
+			progress.Finished = value == "end"
+			cb.onProgress(progress)
+
+			// Note: Keep the previous values around in case the next block
+			// says "N/A"
+			progress.Finished = false
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,78 @@
+package radiochatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const progressOutput = `bitrate=N/A
+total_size=0
+out_time_us=0
+out_time_ms=0
+out_time=00:00:00.000000
+dup_frames=0
+drop_frames=0
+speed=N/A
+progress=continue
+bitrate= 128.0kbits/s
+total_size=80397
+out_time_us=5024875
+out_time_ms=5024875
+out_time=00:00:05.024875
+dup_frames=0
+drop_frames=0
+speed=0.98x
+progress=continue
+bitrate=N/A
+total_size=N/A
+out_time_us=10031000
+out_time_ms=10031000
+out_time=00:00:10.031000
+dup_frames=0
+drop_frames=0
+speed=   1x
+progress=end
+`
+
+func TestParseProgress(t *testing.T) {
+	started := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := started
+	var snapshots []Progress
+	cb := PreprocessingCallbacks{
+		Progress: func(p Progress) { snapshots = append(snapshots, p) },
+	}
+
+	err := parseProgress(strings.NewReader(progressOutput), started, func() time.Time {
+		now = now.Add(6 * time.Second)
+		return now
+	}, cb)
+
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]Progress{
+			{Timestamp: started.Add(6 * time.Second), Drift: 6 * time.Second},
+			{
+				Timestamp: started.Add(12 * time.Second),
+				Bitrate:   128,
+				TotalSize: 80397,
+				OutTime:   5024875 * time.Microsecond,
+				Speed:     0.98,
+				Drift:     12*time.Second - 5024875*time.Microsecond,
+			},
+			{
+				Timestamp: started.Add(18 * time.Second),
+				Bitrate:   128,
+				TotalSize: 80397,
+				OutTime:   10031 * time.Millisecond,
+				Speed:     1,
+				Drift:     18*time.Second - 10031*time.Millisecond,
+				Finished:  true,
+			},
+		},
+		snapshots,
+	)
+}
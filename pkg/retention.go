@@ -0,0 +1,214 @@
+package radiochatter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// DefaultRetentionInterval is how often the retention job checks for expired
+// audio.
+const DefaultRetentionInterval = 1 * time.Hour
+
+// RetentionReport summarises a single pass of the retention job.
+type RetentionReport struct {
+	// How many chunks had their audio expired.
+	ExpiredChunks int `json:"expired-chunks"`
+	// How many transmissions had their audio expired.
+	ExpiredTransmissions int `json:"expired-transmissions"`
+	// How many blobs were deleted. Blobs that are still referenced by
+	// something else are left alone.
+	DeletedBlobs int `json:"deleted-blobs"`
+}
+
+// RunRetention periodically applies each stream's retention settings until
+// the context is cancelled.
+func RunRetention(
+	ctx context.Context,
+	logger *zap.Logger,
+	db *gorm.DB,
+	storage blob.Storage,
+	interval time.Duration,
+) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := ApplyRetention(ctx, logger, db, storage)
+		if err != nil && ctx.Err() == nil {
+			// Note: We'll try again next time
+			logger.Error("Unable to apply the retention settings", zap.Error(err))
+		} else if report.ExpiredChunks > 0 || report.ExpiredTransmissions > 0 {
+			logger.Info("Expired old audio", zap.Any("report", report))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// ApplyRetention deletes the audio for any chunks or transmissions which are
+// older than their stream's retention period.
+//
+// Expired rows are kept (with AudioExpiredAt set) so a chunk's transmissions
+// survive even after the chunk's audio is gone.
+func ApplyRetention(
+	ctx context.Context,
+	logger *zap.Logger,
+	db *gorm.DB,
+	storage blob.Storage,
+) (RetentionReport, error) {
+	return applyRetention(ctx, logger, db, storage, time.Now)
+}
+
+func applyRetention(
+	ctx context.Context,
+	logger *zap.Logger,
+	db *gorm.DB,
+	storage blob.Storage,
+	now func() time.Time,
+) (RetentionReport, error) {
+	var report RetentionReport
+
+	db = db.WithContext(ctx)
+	expiredAt := now().UTC()
+
+	var streams []Stream
+	err := db.Where("chunk_retention > 0 OR transmission_retention > 0").Find(&streams).Error
+	if err != nil {
+		return report, fmt.Errorf("unable to load the streams: %w", err)
+	}
+
+	var keys []string
+
+	for _, stream := range streams {
+		if stream.ChunkRetention > 0 {
+			var chunks []Chunk
+			err := db.Where(
+				"stream_id = ? AND time_stamp < ? AND audio_expired_at IS NULL",
+				stream.ID,
+				expiredAt.Add(-stream.ChunkRetention),
+			).Find(&chunks).Error
+			if err != nil {
+				return report, fmt.Errorf("unable to find expired chunks for %q: %w", stream.DisplayName, err)
+			}
+
+			if len(chunks) > 0 {
+				var ids []uint
+				for _, chunk := range chunks {
+					ids = append(ids, chunk.ID)
+					keys = append(keys, chunk.Sha256)
+					if chunk.RawSha256 != "" {
+						keys = append(keys, chunk.RawSha256)
+					}
+				}
+
+				err := db.Model(&Chunk{}).Where("id IN ?", ids).Update("audio_expired_at", expiredAt).Error
+				if err != nil {
+					return report, fmt.Errorf("unable to expire chunks for %q: %w", stream.DisplayName, err)
+				}
+
+				report.ExpiredChunks += len(chunks)
+			}
+		}
+
+		if stream.TransmissionRetention > 0 {
+			var transmissions []Transmission
+			err := db.Joins("JOIN chunks ON chunks.id = transmissions.chunk_id").
+				Where(
+					"chunks.stream_id = ? AND transmissions.time_stamp < ? AND transmissions.audio_expired_at IS NULL",
+					stream.ID,
+					expiredAt.Add(-stream.TransmissionRetention),
+				).
+				Find(&transmissions).
+				Error
+			if err != nil {
+				return report, fmt.Errorf("unable to find expired transmissions for %q: %w", stream.DisplayName, err)
+			}
+
+			if len(transmissions) > 0 {
+				var ids []uint
+				for _, transmission := range transmissions {
+					ids = append(ids, transmission.ID)
+					keys = append(keys, transmission.Sha256)
+				}
+
+				err := db.Model(&Transmission{}).Where("id IN ?", ids).Update("audio_expired_at", expiredAt).Error
+				if err != nil {
+					return report, fmt.Errorf("unable to expire transmissions for %q: %w", stream.DisplayName, err)
+				}
+
+				report.ExpiredTransmissions += len(transmissions)
+			}
+		}
+	}
+
+	// Note: The rows were marked as expired before deleting anything, so if we
+	// crash part-way through, the garbage collector will clean up after us.
+	for _, key := range keys {
+		deleted, err := deleteIfUnreferenced(ctx, logger, db, storage, key)
+		if err != nil {
+			return report, err
+		}
+		if deleted {
+			report.DeletedBlobs++
+		}
+	}
+
+	return report, nil
+}
+
+// deleteIfUnreferenced deletes a blob unless another row still needs its
+// audio. Blobs are content-addressed, so identical clips share a blob.
+func deleteIfUnreferenced(
+	ctx context.Context,
+	logger *zap.Logger,
+	db *gorm.DB,
+	storage blob.Storage,
+	sha256 string,
+) (bool, error) {
+	key, err := blob.ParseKey(sha256)
+	if err != nil {
+		logger.Warn("Skipping an invalid blob key", zap.String("key", sha256), zap.Error(err))
+		return false, nil
+	}
+
+	references := []struct {
+		model  any
+		column string
+	}{
+		{&Chunk{}, "sha256"},
+		{&Chunk{}, "raw_sha256"},
+		{&Transmission{}, "sha256"},
+	}
+
+	for _, ref := range references {
+		var count int64
+		err := db.Model(ref.model).Where(ref.column+" = ? AND audio_expired_at IS NULL", sha256).Count(&count).Error
+		if err != nil {
+			return false, fmt.Errorf("unable to check whether %s is still referenced: %w", sha256, err)
+		}
+		if count > 0 {
+			return false, nil
+		}
+	}
+
+	err = storage.Delete(ctx, key)
+	if errors.Is(err, blob.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("unable to delete %s: %w", key, err)
+	}
+
+	logger.Debug("Deleted expired audio", zap.Stringer("key", key))
+
+	return true, nil
+}
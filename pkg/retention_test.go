@@ -0,0 +1,77 @@
+package radiochatter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRetentionExpiresChunksButKeepsTransmissions(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	storage := mem_storage.New()
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	stream := Stream{DisplayName: "Test", Url: "...", ChunkRetention: 14 * day}
+	assert.NoError(t, db.Save(&stream).Error)
+	oldKey := storeString(ctx, t, storage, "old chunk")
+	oldRawKey := storeString(ctx, t, storage, "old raw chunk")
+	oldChunk := Chunk{StreamID: stream.ID, TimeStamp: now.Add(-20 * day), Sha256: oldKey.String(), RawSha256: oldRawKey.String()}
+	assert.NoError(t, db.Save(&oldChunk).Error)
+	transmissionKey := storeString(ctx, t, storage, "transmission")
+	transmission := Transmission{ChunkID: oldChunk.ID, TimeStamp: now.Add(-20 * day), Sha256: transmissionKey.String()}
+	assert.NoError(t, db.Save(&transmission).Error)
+	newKey := storeString(ctx, t, storage, "new chunk")
+	newChunk := Chunk{StreamID: stream.ID, TimeStamp: now.Add(-1 * day), Sha256: newKey.String()}
+	assert.NoError(t, db.Save(&newChunk).Error)
+
+	report, err := applyRetention(ctx, logger, db, storage, func() time.Time { return now })
+
+	assert.NoError(t, err)
+	assert.Equal(t, RetentionReport{ExpiredChunks: 1, DeletedBlobs: 2}, report)
+	_, err = storage.Open(ctx, oldKey)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	_, err = storage.Open(ctx, oldRawKey)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	assertBlobExists(ctx, t, storage, newKey)
+	assertBlobExists(ctx, t, storage, transmissionKey)
+	assert.NoError(t, db.First(&oldChunk, oldChunk.ID).Error)
+	if assert.NotNil(t, oldChunk.AudioExpiredAt) {
+		assert.True(t, now.Equal(*oldChunk.AudioExpiredAt))
+	}
+	assert.NoError(t, db.First(&transmission, transmission.ID).Error)
+	assert.Nil(t, transmission.AudioExpiredAt)
+
+	// Running it again shouldn't expire anything new
+	report, err = applyRetention(ctx, logger, db, storage, func() time.Time { return now })
+
+	assert.NoError(t, err)
+	assert.Equal(t, RetentionReport{}, report)
+}
+
+func TestRetentionKeepsBlobsThatAreStillReferenced(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	storage := mem_storage.New()
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	expiring := Stream{DisplayName: "Expiring", Url: "...", ChunkRetention: time.Hour}
+	assert.NoError(t, db.Save(&expiring).Error)
+	forever := Stream{DisplayName: "Forever", Url: "..."}
+	assert.NoError(t, db.Save(&forever).Error)
+	// Both streams recorded exactly the same audio
+	key := storeString(ctx, t, storage, "silence")
+	assert.NoError(t, db.Save(&Chunk{StreamID: expiring.ID, TimeStamp: now.Add(-2 * time.Hour), Sha256: key.String()}).Error)
+	assert.NoError(t, db.Save(&Chunk{StreamID: forever.ID, TimeStamp: now.Add(-2 * time.Hour), Sha256: key.String()}).Error)
+
+	report, err := applyRetention(ctx, logger, db, storage, func() time.Time { return now })
+
+	assert.NoError(t, err)
+	assert.Equal(t, RetentionReport{ExpiredChunks: 1}, report)
+	assertBlobExists(ctx, t, storage, key)
+}
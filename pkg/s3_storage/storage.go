@@ -0,0 +1,396 @@
+package s3_storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"go.uber.org/zap"
+)
+
+// Config contains the settings needed to connect to a S3-compatible bucket.
+type Config struct {
+	// The bucket blobs will be saved to.
+	Bucket string
+	// An optional prefix that will be prepended to every object key.
+	Prefix string
+	// The S3 endpoint to use (e.g. "http://localhost:9000" for a local MinIO
+	// instance). Leave empty to use AWS.
+	Endpoint string
+	// The region the bucket lives in.
+	Region string
+	// Static credentials. If these are empty, the default AWS credential
+	// chain (environment variables, ~/.aws/credentials, etc.) will be used.
+	AccessKeyID     string
+	SecretAccessKey string
+	// Address buckets using "endpoint/bucket/key" instead of
+	// "bucket.endpoint/key". Most self-hosted S3 implementations need this.
+	UsePathStyle bool
+}
+
+// New connects to a S3-compatible object store.
+func New(ctx context.Context, logger *zap.Logger, cfg Config) (*S3Storage, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("no bucket specified")
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	opts := []func(*config.LoadOptions) error{
+		config.WithRegion(region),
+	}
+	if cfg.AccessKeyID != "" || cfg.SecretAccessKey != "" {
+		creds := credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, "")
+		opts = append(opts, config.WithCredentialsProvider(creds))
+	}
+
+	awsConfig, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the AWS config: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		if cfg.Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.Endpoint)
+		}
+		o.UsePathStyle = cfg.UsePathStyle
+	})
+
+	return &S3Storage{
+		logger:  logger,
+		client:  client,
+		presign: s3.NewPresignClient(client),
+		bucket:  cfg.Bucket,
+		prefix:  cfg.Prefix,
+	}, nil
+}
+
+// FromURL creates a S3Storage from a URL like "s3://bucket/prefix".
+//
+// The following query parameters are supported:
+//
+//   - endpoint - the S3 endpoint to use (e.g. "http://localhost:9000")
+//   - region - the bucket's region
+//   - path-style - set to "true" to use path-style addressing
+//   - access-key and secret-key - static credentials
+func FromURL(ctx context.Context, logger *zap.Logger, u *url.URL) (*S3Storage, error) {
+	cfg, err := configFromURL(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(ctx, logger, cfg)
+}
+
+func configFromURL(u *url.URL) (Config, error) {
+	if u.Host == "" {
+		return Config{}, errors.New("no bucket specified")
+	}
+
+	query := u.Query()
+
+	cfg := Config{
+		Bucket:          u.Host,
+		Prefix:          strings.Trim(u.Path, "/"),
+		Endpoint:        query.Get("endpoint"),
+		Region:          query.Get("region"),
+		AccessKeyID:     query.Get("access-key"),
+		SecretAccessKey: query.Get("secret-key"),
+	}
+
+	if raw := query.Get("path-style"); raw != "" {
+		pathStyle, err := strconv.ParseBool(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid path-style parameter: %w", err)
+		}
+		cfg.UsePathStyle = pathStyle
+	}
+
+	return cfg, nil
+}
+
+// S3Storage is a blob.Storage implementation backed by a S3-compatible
+// object store.
+type S3Storage struct {
+	logger  *zap.Logger
+	client  *s3.Client
+	presign *s3.PresignClient
+	bucket  string
+	prefix  string
+}
+
+func (s *S3Storage) Close() error {
+	return nil
+}
+
+func (s *S3Storage) objectKey(key blob.Key) string {
+	return path.Join(s.prefix, key.String())
+}
+
+func (s *S3Storage) exists(ctx context.Context, key blob.Key) (bool, error) {
+	_, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+	})
+
+	if isNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *S3Storage) Link(ctx context.Context, key blob.Key, validFor time.Duration) (*url.URL, error) {
+	objectKey := s.objectKey(key)
+
+	exists, err := s.exists(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find %q: %w", objectKey, err)
+	} else if !exists {
+		return nil, fmt.Errorf("unable to find %q: %w", objectKey, blob.ErrNotFound)
+	}
+
+	req, err := s.presign.PresignGetObject(
+		ctx,
+		&s3.GetObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(objectKey),
+		},
+		s3.WithPresignExpires(validFor),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to presign a link to %q: %w", objectKey, err)
+	}
+
+	return url.Parse(req.URL)
+}
+
+func (s *S3Storage) Store(ctx context.Context, data []byte) (blob.Key, error) {
+	key := blob.KeyForBytes(data)
+	mediaType := blob.DetectMediaType(data)
+
+	if err := s.upload(ctx, key, bytes.NewReader(data), int64(len(data)), mediaType); err != nil {
+		return blob.Key{}, err
+	}
+
+	return key, nil
+}
+
+func (s *S3Storage) StoreReader(ctx context.Context, r io.Reader) (blob.Key, error) {
+	var key blob.Key
+
+	err := s.spool(r, func(f *os.File, contentKey blob.Key, size int64, mediaType string) error {
+		key = contentKey
+		return s.upload(ctx, key, f, size, mediaType)
+	})
+	if err != nil {
+		return blob.Key{}, err
+	}
+
+	return key, nil
+}
+
+// StoreAt saves a blob under a particular key, replacing any existing blob.
+func (s *S3Storage) StoreAt(ctx context.Context, key blob.Key, r io.Reader) error {
+	return s.spool(r, func(f *os.File, _ blob.Key, size int64, mediaType string) error {
+		return s.put(ctx, key, f, size, mediaType)
+	})
+}
+
+// spool copies a blob to a temporary file before passing it to upload.
+//
+// We need to know a blob's size (and usually its key) before it can be
+// uploaded.
+func (s *S3Storage) spool(r io.Reader, upload func(f *os.File, key blob.Key, size int64, mediaType string) error) error {
+	f, key, size, err := blob.Spool("", r)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+		if err := os.Remove(f.Name()); err != nil {
+			s.logger.Warn("Unable to remove the temporary file", zap.String("path", f.Name()), zap.Error(err))
+		}
+	}()
+
+	mediaType, err := blob.SniffMediaType(f)
+	if err != nil {
+		return fmt.Errorf("unable to detect the media type of %s: %w", key, err)
+	}
+
+	return upload(f, key, size, mediaType)
+}
+
+// upload saves a blob to the bucket, skipping the upload if it already exists.
+func (s *S3Storage) upload(ctx context.Context, key blob.Key, body io.Reader, size int64, mediaType string) error {
+	objectKey := s.objectKey(key)
+
+	exists, err := s.exists(ctx, key)
+	if err != nil {
+		return fmt.Errorf("unable to check whether %q exists: %w", objectKey, err)
+	} else if exists {
+		s.logger.Debug(
+			"Already exists",
+			zap.String("bucket", s.bucket),
+			zap.String("object", objectKey),
+			zap.Stringer("key", key),
+		)
+		return nil
+	}
+
+	return s.put(ctx, key, body, size, mediaType)
+}
+
+// put uploads a blob to the bucket, replacing any existing object.
+//
+// Blobs are content-addressed, so the object is marked as immutable to let
+// browsers and CDNs cache it indefinitely.
+func (s *S3Storage) put(ctx context.Context, key blob.Key, body io.Reader, size int64, mediaType string) error {
+	objectKey := s.objectKey(key)
+
+	s.logger.Debug(
+		"Uploading blob",
+		zap.String("bucket", s.bucket),
+		zap.String("object", objectKey),
+		zap.Stringer("key", key),
+		zap.Int64("bytes", size),
+		zap.String("media-type", mediaType),
+	)
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(objectKey),
+		Body:          body,
+		ContentLength: aws.Int64(size),
+		ContentType:   aws.String(mediaType),
+		CacheControl:  aws.String("public, max-age=31536000, immutable"),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to upload %q: %w", objectKey, err)
+	}
+
+	return nil
+}
+
+func (s *S3Storage) Open(ctx context.Context, key blob.Key) (io.ReadCloser, error) {
+	objectKey := s.objectKey(key)
+
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(objectKey),
+	})
+	if isNotFound(err) {
+		return nil, fmt.Errorf("unable to find %q: %w", objectKey, blob.ErrNotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to download %q: %w", objectKey, err)
+	}
+
+	return output.Body, nil
+}
+
+func (s *S3Storage) List(ctx context.Context, fn func(blob.Info) error) error {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+	}
+	if s.prefix != "" {
+		input.Prefix = aws.String(strings.TrimSuffix(s.prefix, "/") + "/")
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(s.client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to list the objects in %q: %w", s.bucket, err)
+		}
+
+		for _, object := range page.Contents {
+			objectKey := aws.ToString(object.Key)
+
+			key, err := blob.ParseKey(path.Base(objectKey))
+			if err != nil || s.objectKey(key) != objectKey {
+				// Not one of ours
+				continue
+			}
+
+			info := blob.Info{
+				Key:          key,
+				Size:         aws.ToInt64(object.Size),
+				LastModified: aws.ToTime(object.LastModified),
+			}
+			if err := fn(info); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key blob.Key) error {
+	objectKey := s.objectKey(key)
+
+	// Note: S3 doesn't complain when you delete something that doesn't
+	// exist, so we need to check first.
+	exists, err := s.exists(ctx, key)
+	if err != nil {
+		return fmt.Errorf("unable to find %q: %w", objectKey, err)
+	} else if !exists {
+		return fmt.Errorf("unable to find %q: %w", objectKey, blob.ErrNotFound)
+	}
+
+	_, err = s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete %q: %w", objectKey, err)
+	}
+
+	s.logger.Debug(
+		"Deleted blob",
+		zap.String("bucket", s.bucket),
+		zap.String("object", objectKey),
+		zap.Stringer("key", key),
+	)
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var notFound *types.NotFound
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &notFound) || errors.As(err, &noSuchKey) {
+		return true
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "NotFound", "NoSuchKey":
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,188 @@
+package s3_storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/johannesboyne/gofakes3"
+	"github.com/johannesboyne/gofakes3/backend/s3mem"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestStoreBlobAndReadItBack(t *testing.T) {
+	storage := testStorage(t)
+	blob := "Hello, World"
+
+	key, err := storage.Store(context.Background(), []byte(blob))
+	assert.NoError(t, err)
+	link, err := storage.Link(context.Background(), key, 1*time.Hour)
+	assert.NoError(t, err)
+	response, err := http.Get(link.String())
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, blob, string(body))
+}
+
+func TestUploadsRecordTheMediaType(t *testing.T) {
+	storage := testStorage(t)
+	key, err := storage.StoreReader(context.Background(), strings.NewReader("ID3\x04\x00 pretend this is an mp3"))
+	assert.NoError(t, err)
+	link, err := storage.Link(context.Background(), key, 1*time.Hour)
+	assert.NoError(t, err)
+
+	response, err := http.Get(link.String())
+	assert.NoError(t, err)
+	response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "audio/mpeg", response.Header.Get("Content-Type"))
+}
+
+func TestStoreIsIdempotent(t *testing.T) {
+	storage := testStorage(t)
+
+	first, err := storage.Store(context.Background(), []byte("Hello, World"))
+	assert.NoError(t, err)
+	second, err := storage.Store(context.Background(), []byte("Hello, World"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, first, second)
+}
+
+func TestStoreAtReplacesExistingBlobs(t *testing.T) {
+	storage := testStorage(t)
+	ctx := context.Background()
+	key := blob.KeyForBytes([]byte("plaintext"))
+
+	assert.NoError(t, storage.StoreAt(ctx, key, strings.NewReader("first")))
+	assert.NoError(t, storage.StoreAt(ctx, key, strings.NewReader("second")))
+
+	r, err := storage.Open(ctx, key)
+	assert.NoError(t, err)
+	defer r.Close()
+	body, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "second", string(body))
+}
+
+func TestStreamBlobInAndOut(t *testing.T) {
+	storage := testStorage(t)
+	content := "Hello, World"
+
+	key, err := storage.StoreReader(context.Background(), strings.NewReader(content))
+	assert.NoError(t, err)
+	r, err := storage.Open(context.Background(), key)
+	assert.NoError(t, err)
+	defer r.Close()
+	body, err := io.ReadAll(r)
+	assert.NoError(t, err)
+
+	assert.Equal(t, blob.KeyForBytes([]byte(content)), key)
+	assert.Equal(t, content, string(body))
+}
+
+func TestOpenMissingBlob(t *testing.T) {
+	storage := testStorage(t)
+
+	_, err := storage.Open(context.Background(), blob.KeyForBytes([]byte("missing")))
+
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestListAndDeleteBlobs(t *testing.T) {
+	storage := testStorage(t)
+	ctx := context.Background()
+	first, err := storage.Store(ctx, []byte("first"))
+	assert.NoError(t, err)
+	second, err := storage.Store(ctx, []byte("second"))
+	assert.NoError(t, err)
+
+	var keys []blob.Key
+	err = storage.List(ctx, func(info blob.Info) error {
+		keys = append(keys, info.Key)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []blob.Key{first, second}, keys)
+
+	assert.NoError(t, storage.Delete(ctx, first))
+	assert.ErrorIs(t, storage.Delete(ctx, first), blob.ErrNotFound)
+	_, err = storage.Open(ctx, first)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestLinkToMissingBlob(t *testing.T) {
+	storage := testStorage(t)
+
+	_, err := storage.Link(context.Background(), blob.KeyForBytes([]byte("missing")), 1*time.Hour)
+
+	assert.True(t, errors.Is(err, blob.ErrNotFound))
+}
+
+func TestLinksRespectValidFor(t *testing.T) {
+	storage := testStorage(t)
+	key, err := storage.Store(context.Background(), []byte("Hello, World"))
+	assert.NoError(t, err)
+
+	link, err := storage.Link(context.Background(), key, 5*time.Minute)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "300", link.Query().Get("X-Amz-Expires"))
+}
+
+func TestConfigFromURL(t *testing.T) {
+	u, err := url.Parse("s3://radio-chatter/audio/blobs/?endpoint=http://localhost:9000&region=ap-southeast-2&path-style=true&access-key=abc&secret-key=xyz")
+	assert.NoError(t, err)
+
+	cfg, err := configFromURL(u)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Config{
+		Bucket:          "radio-chatter",
+		Prefix:          "audio/blobs",
+		Endpoint:        "http://localhost:9000",
+		Region:          "ap-southeast-2",
+		AccessKeyID:     "abc",
+		SecretAccessKey: "xyz",
+		UsePathStyle:    true,
+	}, cfg)
+}
+
+// testStorage creates a S3Storage backed by an in-process fake S3 server.
+func testStorage(t *testing.T) *S3Storage {
+	t.Helper()
+
+	backend := s3mem.New()
+	faker := gofakes3.New(backend)
+	server := httptest.NewServer(faker.Server())
+	t.Cleanup(server.Close)
+
+	const bucket = "radio-chatter"
+	assert.NoError(t, backend.CreateBucket(bucket))
+
+	storage, err := New(context.Background(), zaptest.NewLogger(t), Config{
+		Bucket:          bucket,
+		Prefix:          "blobs",
+		Endpoint:        server.URL,
+		AccessKeyID:     "access-key",
+		SecretAccessKey: "secret-key",
+		UsePathStyle:    true,
+	})
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = storage.Close() })
+
+	return storage
+}
@@ -0,0 +1,165 @@
+package radiochatter
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Segment is a chunk that ffmpeg has finished writing, as reported in its
+// segment list.
+type Segment struct {
+	Path string
+	// When the chunk starts, relative to the start of the input.
+	Start time.Duration
+	// When the chunk ends, relative to the start of the input.
+	End time.Duration
+}
+
+// chunkTimestampPattern is the wall-clock naming used for chunks from live
+// streams (minus the extension), and chunkTimestampLayout is the same
+// timestamp as a Go layout.
+const (
+	chunkTimestampPattern = "chunk_%Y%m%dT%H%M%SZ"
+	chunkTimestampLayout  = "20060102T150405Z"
+)
+
+// parseChunkTimestamp gets the time a chunk was started from its name, if it
+// was named using chunkTimestampPattern.
+func parseChunkTimestamp(path string) (time.Time, bool) {
+	// Note: The "chunk_" prefix can't go in the layout because "_2" means
+	// a space-padded day
+	name, ok := strings.CutPrefix(filepath.Base(path), "chunk_")
+	if !ok {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(chunkTimestampLayout, strings.TrimSuffix(name, filepath.Ext(name)))
+	return t, err == nil
+}
+
+// rawChunkPrefix is added to the names of the untouched copies of chunks that
+// are written when a stream keeps its raw audio.
+const rawChunkPrefix = "raw_"
+
+// rawChunkPath gets where ffmpeg writes the untouched copy of the n'th chunk,
+// given the path of the cleaned up one.
+//
+// Note: Raw chunks are always numbered, even for live streams, because the
+// two copies could be opened either side of a second boundary.
+func rawChunkPath(chunk string, n int) string {
+	name := fmt.Sprintf("%schunk_%d%s", rawChunkPrefix, n, filepath.Ext(chunk))
+	return filepath.Join(filepath.Dir(chunk), name)
+}
+
+func isRawChunk(path string) bool {
+	return strings.HasPrefix(filepath.Base(path), rawChunkPrefix)
+}
+
+// isLiveInput guesses whether ffmpeg's input is a live stream rather than a
+// file on disk.
+func isLiveInput(input string) bool {
+	return strings.Contains(input, "://") && !strings.HasPrefix(input, "file://")
+}
+
+// parseSegmentList reads the CSV segment list written by ffmpeg's segment
+// muxer, triggering the SegmentComplete callback for each entry.
+//
+// Each line looks like "chunk_0.mp3,0.000000,60.024000".
+func parseSegmentList(r io.Reader, outputDir string, cb PreprocessingCallbacks) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("unable to read the segment list: %w", err)
+		}
+
+		start, err := parseSeconds(record[1])
+		if err != nil {
+			return err
+		}
+		end, err := parseSeconds(record[2])
+		if err != nil {
+			return err
+		}
+
+		path := record[0]
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(outputDir, path)
+		}
+
+		cb.onSegmentComplete(Segment{Path: path, Start: start, End: end})
+	}
+}
+
+// segmentListTimeout is how long to wait for a chunk's entry in the segment
+// list before giving up on it.
+const segmentListTimeout = 5 * time.Second
+
+// segmentGate makes sure the entry for a chunk in the segment list is seen
+// before ffmpeg is reported as starting the next one.
+//
+// Ffmpeg always writes the entry before opening the next chunk, but stderr and
+// the segment list are read by different goroutines.
+type segmentGate struct {
+	mu        sync.Mutex
+	completed int
+	closed    bool
+	changed   chan struct{}
+}
+
+func newSegmentGate() *segmentGate {
+	return &segmentGate{changed: make(chan struct{})}
+}
+
+// Wait blocks until at least n segments have been completed, returning false
+// if that didn't happen in time.
+func (g *segmentGate) Wait(n int, timeout time.Duration) bool {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		g.mu.Lock()
+		done := g.completed >= n || g.closed
+		changed := g.changed
+		g.mu.Unlock()
+
+		if done {
+			return true
+		}
+
+		select {
+		case <-changed:
+		case <-deadline.C:
+			return false
+		}
+	}
+}
+
+// SegmentCompleted records that another segment was seen.
+func (g *segmentGate) SegmentCompleted() {
+	g.update(func() { g.completed++ })
+}
+
+// Close stops anyone from waiting on more segments.
+func (g *segmentGate) Close() {
+	g.update(func() { g.closed = true })
+}
+
+func (g *segmentGate) update(f func()) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	f()
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
@@ -0,0 +1,57 @@
+package radiochatter
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSegmentList(t *testing.T) {
+	list := "chunk_0.mp3,0.000000,60.048000\n" +
+		"\"chunk, with a comma.mp3\",60.048000,120.096000\n" +
+		"/absolute/chunk_2.mp3,120.096000,130.5\n"
+	var segments []Segment
+	cb := PreprocessingCallbacks{
+		SegmentComplete: func(seg Segment) { segments = append(segments, seg) },
+	}
+
+	err := parseSegmentList(strings.NewReader(list), "/tmp/output", cb)
+
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]Segment{
+			{Path: filepath.Join("/tmp/output", "chunk_0.mp3"), Start: 0, End: 60048 * time.Millisecond},
+			{Path: filepath.Join("/tmp/output", "chunk, with a comma.mp3"), Start: 60048 * time.Millisecond, End: 120096 * time.Millisecond},
+			{Path: "/absolute/chunk_2.mp3", Start: 120096 * time.Millisecond, End: 130500 * time.Millisecond},
+		},
+		segments,
+	)
+}
+
+func TestSegmentGateWaitsForTheSegmentList(t *testing.T) {
+	gate := newSegmentGate()
+
+	assert.False(t, gate.Wait(1, time.Millisecond))
+
+	go gate.SegmentCompleted()
+	assert.True(t, gate.Wait(1, time.Minute))
+
+	gate.Close()
+	assert.True(t, gate.Wait(100, time.Minute))
+}
+
+func TestOnlyLiveInputsUseWallClockNames(t *testing.T) {
+	assert.True(t, isLiveInput("https://broadcastify.cdnstream1.com/39131"))
+	assert.False(t, isLiveInput("/tmp/recording.mp3"))
+	assert.False(t, isLiveInput("file:///tmp/recording.mp3"))
+
+	named, ok := parseChunkTimestamp("/tmp/chunk_20240301T123456Z.mp3")
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 3, 1, 12, 34, 56, 0, time.UTC), named)
+	_, ok = parseChunkTimestamp("/tmp/chunk_3.mp3")
+	assert.False(t, ok)
+}
@@ -0,0 +1,68 @@
+package radiochatter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/on_disk_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/s3_storage"
+	"go.uber.org/zap"
+)
+
+type storageOpener = func(ctx context.Context, logger *zap.Logger, u *url.URL) (blob.Storage, error)
+
+var storageOpeners = map[string]storageOpener{
+	"file": func(ctx context.Context, logger *zap.Logger, u *url.URL) (blob.Storage, error) {
+		return on_disk_storage.FromURL(ctx, logger, u)
+	},
+	"mem": func(ctx context.Context, logger *zap.Logger, u *url.URL) (blob.Storage, error) {
+		logger.Warn("Blobs are only kept in memory and will be lost when the process exits")
+		return mem_storage.New(), nil
+	},
+	"s3": func(ctx context.Context, logger *zap.Logger, u *url.URL) (blob.Storage, error) {
+		return s3_storage.FromURL(ctx, logger, u)
+	},
+}
+
+// OpenStorage creates a blob.Storage from a URL, where the URL's scheme
+// determines which backend is used (e.g. "file:///var/blobs",
+// "s3://bucket/prefix", or "mem://" for throwaway storage). Each backend is
+// responsible for parsing its own options from the rest of the URL.
+//
+// For backwards compatibility, a URL without a scheme is treated as a path to
+// a directory on disk.
+func OpenStorage(ctx context.Context, logger *zap.Logger, rawURL string) (blob.Storage, error) {
+	u, err := parseStorageURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	opener, ok := storageOpeners[u.Scheme]
+	if !ok {
+		var schemes []string
+		for k := range storageOpeners {
+			schemes = append(schemes, k)
+		}
+		sort.Strings(schemes)
+		return nil, fmt.Errorf("unknown blob storage scheme, %q, expected one of %s", u.Scheme, strings.Join(schemes, ", "))
+	}
+
+	logger.Debug("Opening blob storage", zap.String("scheme", u.Scheme))
+
+	return opener(ctx, logger, u)
+}
+
+func parseStorageURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme == "" {
+		// Looks like a plain path
+		return &url.URL{Scheme: "file", Path: rawURL}, nil
+	}
+
+	return u, nil
+}
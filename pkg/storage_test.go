@@ -0,0 +1,44 @@
+package radiochatter
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/on_disk_storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestOpenStorageByScheme(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	dir := t.TempDir()
+
+	for _, input := range []string{"file://" + dir, dir, filepath.Join(dir, "nested")} {
+		storage, err := OpenStorage(ctx, logger, input)
+
+		assert.NoError(t, err, input)
+		assert.IsType(t, &on_disk_storage.OnDiskStorage{}, storage, input)
+		assert.NoError(t, storage.Close())
+	}
+}
+
+func TestOpenStorageWithUnknownScheme(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+
+	_, err := OpenStorage(ctx, logger, "ftp://example.com/blobs")
+
+	assert.EqualError(t, err, `unknown blob storage scheme, "ftp", expected one of file, mem, s3`)
+}
+
+func TestOpenInMemoryStorage(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+
+	storage, err := OpenStorage(ctx, logger, "mem://")
+
+	assert.NoError(t, err)
+	assert.IsType(t, &mem_storage.MemStorage{}, storage)
+}
@@ -0,0 +1,215 @@
+package radiochatter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Backoff controls how long to wait before restarting ffmpeg after it exits.
+type Backoff struct {
+	// The delay before the first restart.
+	Initial time.Duration
+	// The longest we'll ever wait between restarts.
+	Max time.Duration
+	// How much the delay grows after each consecutive failure.
+	Multiplier float64
+	// How much to randomly vary each delay by, as a fraction of the delay.
+	// This stops every stream from hammering the same server in lockstep.
+	Jitter float64
+	// If ffmpeg stays up for at least this long, the next restart will go
+	// back to using the initial delay.
+	ResetAfter time.Duration
+}
+
+func DefaultBackoff() Backoff {
+	return Backoff{
+		Initial:    1 * time.Second,
+		Max:        5 * time.Minute,
+		Multiplier: 2,
+		Jitter:     0.2,
+		ResetAfter: 1 * time.Minute,
+	}
+}
+
+// Delay calculates how long to wait before a particular restart attempt,
+// where the first attempt is 1. The random number must be in [0, 1).
+func (b Backoff) Delay(attempt int, random float64) time.Duration {
+	delay := float64(b.Initial) * math.Pow(b.Multiplier, float64(attempt-1))
+
+	// Spread the delay evenly around the nominal value
+	delay *= 1 + b.Jitter*(2*random-1)
+
+	// Note: Clamp after adding jitter so we never wait longer than Max
+	if delay > float64(b.Max) || math.IsInf(delay, 0) {
+		delay = float64(b.Max)
+	}
+
+	return time.Duration(delay)
+}
+
+// RestartPolicy decides whether a stream should be restarted after its
+// pipeline stops.
+type RestartPolicy string
+
+const (
+	// Always restart the stream, even if ffmpeg exited cleanly (e.g. because
+	// the server closed the connection).
+	RestartAlways RestartPolicy = "always"
+	// Only restart the stream if something went wrong.
+	RestartOnFailure RestartPolicy = "on-failure"
+)
+
+func ParseRestartPolicy(raw string) (RestartPolicy, error) {
+	switch policy := RestartPolicy(raw); policy {
+	case "", RestartAlways:
+		return RestartAlways, nil
+	case RestartOnFailure:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown restart policy, %q, expected one of %s, %s", raw, RestartAlways, RestartOnFailure)
+	}
+}
+
+// supervisor runs something repeatedly until the context is cancelled,
+// waiting a bit longer between each consecutive failure.
+type supervisor struct {
+	logger  *zap.Logger
+	backoff Backoff
+	policy  RestartPolicy
+	// Give up after this many consecutive failures. Zero means never give
+	// up.
+	maxFailures int
+	// Run a single epoch.
+	run func(ctx context.Context, epoch int) error
+	// Called every time a new epoch is started after the first.
+	onReconnect func(r Reconnect)
+	// Called whenever the supervisor's state changes.
+	onStatus func(status StreamStatus)
+	// Called every time an epoch stops.
+	onStopped func(at time.Time, reason string)
+	now       func() time.Time
+	random    func() float64
+}
+
+// Run keeps restarting the supervised function until the context is
+// cancelled or the restart policy says to stop. An error is returned if the
+// supervisor gave up.
+func (s supervisor) Run(ctx context.Context) error {
+	attempt := 0
+	failures := 0
+	var lastErr error
+
+	for epoch := 0; ; epoch++ {
+		s.report(StreamStatus{State: StreamRunning, Failures: failures, LastError: errorMessage(lastErr)})
+
+		started := s.now()
+		err := s.run(ctx, epoch)
+		if ctx.Err() != nil {
+			// We were asked to stop
+			s.stopped(s.now(), "recording was stopped")
+			s.report(StreamStatus{State: StreamStopped, Failures: failures, LastError: errorMessage(lastErr)})
+			return nil
+		}
+		stopped := s.now()
+
+		if stopped.Sub(started) >= s.backoff.ResetAfter {
+			attempt = 0
+			failures = 0
+		}
+		attempt++
+
+		reason := "ffmpeg exited"
+		if err != nil {
+			reason = err.Error()
+			lastErr = err
+			failures++
+		}
+		s.stopped(stopped, reason)
+
+		if err == nil && s.policy == RestartOnFailure {
+			s.logger.Info("Preprocessing finished", zap.Int("epoch", epoch))
+			s.report(StreamStatus{State: StreamStopped, Failures: failures, LastError: errorMessage(lastErr)})
+			return nil
+		}
+
+		if err != nil && s.maxFailures > 0 && failures >= s.maxFailures {
+			s.logger.Error(
+				"Giving up after too many failures",
+				zap.Int("epoch", epoch),
+				zap.Int("failures", failures),
+				zap.Error(err),
+			)
+			s.report(StreamStatus{State: StreamFailed, Failures: failures, LastError: reason})
+			return fmt.Errorf("gave up after %d failures: %w", failures, err)
+		}
+
+		delay := s.backoff.Delay(attempt, s.random())
+		s.logger.Warn(
+			"Preprocessing stopped, restarting",
+			zap.Int("epoch", epoch),
+			zap.Int("attempt", attempt),
+			zap.Duration("uptime", stopped.Sub(started)),
+			zap.Duration("delay", delay),
+			zap.Error(err),
+		)
+
+		retryAt := stopped.Add(delay)
+		s.report(StreamStatus{State: StreamBackingOff, Failures: failures, LastError: errorMessage(lastErr), RetryAt: &retryAt})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			s.report(StreamStatus{State: StreamStopped, Failures: failures, LastError: errorMessage(lastErr)})
+			return nil
+		}
+
+		if s.onReconnect != nil {
+			s.onReconnect(Reconnect{
+				Epoch:          epoch + 1,
+				Attempt:        attempt,
+				DisconnectedAt: stopped,
+				ReconnectedAt:  s.now(),
+				Reason:         reason,
+			})
+		}
+	}
+}
+
+func (s supervisor) report(status StreamStatus) {
+	if s.onStatus != nil {
+		status.UpdatedAt = s.now()
+		s.onStatus(status)
+	}
+}
+
+func (s supervisor) stopped(at time.Time, reason string) {
+	if s.onStopped != nil {
+		s.onStopped(at, reason)
+	}
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+func newSupervisor(logger *zap.Logger, backoff Backoff, run func(ctx context.Context, epoch int) error) supervisor {
+	return supervisor{
+		logger:  logger,
+		backoff: backoff,
+		policy:  RestartAlways,
+		run:     run,
+		now:     time.Now,
+		random:  rand.Float64,
+	}
+}
@@ -0,0 +1,146 @@
+package radiochatter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestBackoffGrowsExponentiallyUpToTheMax(t *testing.T) {
+	backoff := Backoff{Initial: time.Second, Max: 10 * time.Second, Multiplier: 2}
+
+	var delays []time.Duration
+	for attempt := 1; attempt <= 6; attempt++ {
+		delays = append(delays, backoff.Delay(attempt, 0.5))
+	}
+
+	assert.Equal(
+		t,
+		[]time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second},
+		delays,
+	)
+}
+
+func TestBackoffJitter(t *testing.T) {
+	backoff := Backoff{Initial: 10 * time.Second, Max: time.Minute, Multiplier: 2, Jitter: 0.2}
+
+	assert.Equal(t, 8*time.Second, backoff.Delay(1, 0))
+	assert.Equal(t, 10*time.Second, backoff.Delay(1, 0.5))
+	assert.Equal(t, 11*time.Second, backoff.Delay(1, 0.75))
+	// Jitter never pushes the delay past the maximum
+	assert.Equal(t, 44*time.Second, backoff.Delay(3, 0.75))
+	assert.Equal(t, time.Minute, backoff.Delay(4, 0.75))
+	assert.Equal(t, time.Minute, backoff.Delay(10, 0))
+}
+
+func TestSupervisorRestartsUntilCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(testContext(t))
+	defer cancel()
+	var epochs []int
+	var reconnects []Reconnect
+	s := newSupervisor(zaptest.NewLogger(t), fastBackoff(), func(ctx context.Context, epoch int) error {
+		epochs = append(epochs, epoch)
+		if epoch == 2 {
+			// Pretend we stay connected until someone shuts us down
+			cancel()
+			<-ctx.Done()
+			return nil
+		}
+		return errors.New("connection reset by peer")
+	})
+	s.onReconnect = func(r Reconnect) { reconnects = append(reconnects, r) }
+
+	err := s.Run(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, epochs)
+	if assert.Len(t, reconnects, 2) {
+		assert.Equal(t, 1, reconnects[0].Epoch)
+		assert.Equal(t, 1, reconnects[0].Attempt)
+		assert.Equal(t, "connection reset by peer", reconnects[0].Reason)
+		assert.Equal(t, 2, reconnects[1].Epoch)
+		assert.Equal(t, 2, reconnects[1].Attempt)
+		assert.False(t, reconnects[1].ReconnectedAt.Before(reconnects[1].DisconnectedAt))
+	}
+}
+
+func TestSupervisorResetsTheBackoffAfterAStableConnection(t *testing.T) {
+	ctx, cancel := context.WithCancel(testContext(t))
+	defer cancel()
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	var reconnects []Reconnect
+	backoff := fastBackoff()
+	backoff.ResetAfter = time.Minute
+	s := newSupervisor(zaptest.NewLogger(t), backoff, func(ctx context.Context, epoch int) error {
+		if epoch == 2 {
+			cancel()
+			return nil
+		}
+		// Every connection stays up for an hour
+		now = now.Add(time.Hour)
+		return nil
+	})
+	s.now = func() time.Time { return now }
+	s.onReconnect = func(r Reconnect) { reconnects = append(reconnects, r) }
+
+	assert.NoError(t, s.Run(ctx))
+
+	if assert.Len(t, reconnects, 2) {
+		assert.Equal(t, 1, reconnects[0].Attempt)
+		assert.Equal(t, 1, reconnects[1].Attempt)
+		assert.Equal(t, "ffmpeg exited", reconnects[1].Reason)
+	}
+}
+
+func TestSupervisorOnlyRestartsOnFailureWhenAskedTo(t *testing.T) {
+	ctx := testContext(t)
+	runs := 0
+	var statuses []StreamStatus
+	s := newSupervisor(zaptest.NewLogger(t), fastBackoff(), func(ctx context.Context, epoch int) error {
+		runs++
+		if epoch == 0 {
+			return errors.New("oops")
+		}
+		return nil
+	})
+	s.policy = RestartOnFailure
+	s.onStatus = func(status StreamStatus) { statuses = append(statuses, status) }
+
+	err := s.Run(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, runs)
+	var states []StreamState
+	for _, status := range statuses {
+		states = append(states, status.State)
+	}
+	assert.Equal(t, []StreamState{StreamRunning, StreamBackingOff, StreamRunning, StreamStopped}, states)
+	assert.Equal(t, "oops", statuses[len(statuses)-1].LastError)
+}
+
+func TestSupervisorGivesUpAfterTooManyFailures(t *testing.T) {
+	ctx := testContext(t)
+	runs := 0
+	var last StreamStatus
+	s := newSupervisor(zaptest.NewLogger(t), fastBackoff(), func(ctx context.Context, epoch int) error {
+		runs++
+		return errors.New("404 Not Found")
+	})
+	s.maxFailures = 3
+	s.onStatus = func(status StreamStatus) { last = status }
+	var reasons []string
+	s.onStopped = func(at time.Time, reason string) { reasons = append(reasons, reason) }
+
+	err := s.Run(ctx)
+
+	assert.Error(t, err)
+	assert.Equal(t, 3, runs)
+	assert.Equal(t, []string{"404 Not Found", "404 Not Found", "404 Not Found"}, reasons)
+	assert.Equal(t, StreamFailed, last.State)
+	assert.Equal(t, 3, last.Failures)
+	assert.Equal(t, "404 Not Found", last.LastError)
+}
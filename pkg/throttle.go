@@ -0,0 +1,72 @@
+package radiochatter
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// throttle limits the rate that data can be read across any number of
+// readers.
+type throttle struct {
+	ctx            context.Context
+	bytesPerSecond int64
+	started        time.Time
+	bytesRead      int64
+}
+
+// newThrottle creates a throttle which limits reads to a certain number of
+// bytes per second. A limit of 0 disables throttling.
+func newThrottle(ctx context.Context, bytesPerSecond int64) *throttle {
+	return &throttle{
+		ctx:            ctx,
+		bytesPerSecond: bytesPerSecond,
+		started:        time.Now(),
+	}
+}
+
+// Reader wraps a reader so it is subject to the throttle's limit.
+func (t *throttle) Reader(r io.Reader) io.Reader {
+	if t.bytesPerSecond <= 0 {
+		return r
+	}
+
+	return throttledReader{inner: r, t: t}
+}
+
+// wait blocks until reading another n bytes would keep us under the limit.
+func (t *throttle) wait(n int) error {
+	t.bytesRead += int64(n)
+
+	expected := time.Duration(float64(t.bytesRead) / float64(t.bytesPerSecond) * float64(time.Second))
+	delay := expected - time.Since(t.started)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-t.ctx.Done():
+		return t.ctx.Err()
+	}
+}
+
+type throttledReader struct {
+	inner io.Reader
+	t     *throttle
+}
+
+func (r throttledReader) Read(p []byte) (int, error) {
+	n, err := r.inner.Read(p)
+	if n > 0 {
+		if waitErr := r.t.wait(n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+
+	return n, err
+}
@@ -6,8 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
-	"net/url"
 	"os"
 	"os/exec"
 	"path"
@@ -24,11 +22,15 @@ import (
 // transcribe at a time.
 const MaxSpeechToTextBatchSize = 50
 const DefaultWhisperModel = "large-v2"
+
+// DefaultPollInterval is how often the database is checked for new rows when
+// nothing else has been configured.
+const DefaultPollInterval = 60 * time.Second
 const whisperCommand = "whisper"
 
 type SpeechToText interface {
-	// Transcribe the audio files located at the provided URLs into english.
-	SpeechToText(ctx context.Context, urls []*url.URL) ([]string, error)
+	// Transcribe the audio clips saved under the provided keys into english.
+	SpeechToText(ctx context.Context, storage blob.Storage, keys []blob.Key) ([]string, error)
 	// How many audio files can be translated in a single batch.
 	//
 	// You can pass more than this number to SpeechToText(), but the
@@ -45,7 +47,14 @@ type transcriber struct {
 
 // Transcribe will continuously poll the database for new messages and run
 // speech-to-text on them.
-func Transcribe(ctx context.Context, logger *zap.Logger, db *gorm.DB, stt SpeechToText, storage blob.Storage) error {
+//
+// There's no point polling more often than chunks are generated, so the poll
+// interval should normally be similar to the chunk length.
+func Transcribe(ctx context.Context, logger *zap.Logger, db *gorm.DB, stt SpeechToText, storage blob.Storage, pollInterval time.Duration) error {
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+
 	t := transcriber{
 		logger:  logger,
 		db:      db.WithContext(ctx),
@@ -53,10 +62,6 @@ func Transcribe(ctx context.Context, logger *zap.Logger, db *gorm.DB, stt Speech
 		storage: storage,
 	}
 
-	// Note: there's no point polling more rapidly than chunks are generated
-	ticker := time.NewTicker(ChunkLength)
-	defer ticker.Stop()
-
 	for {
 		// Clear out the backlog. We do this syncronously because it lets us
 		// provide backpressure if the upstream service is too slow.
@@ -79,7 +84,7 @@ func Transcribe(ctx context.Context, logger *zap.Logger, db *gorm.DB, stt Speech
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-time.After(ChunkLength):
+		case <-time.After(pollInterval):
 		}
 	}
 }
@@ -95,25 +100,21 @@ func (t *transcriber) transcribeOnce(ctx context.Context) (int, error) {
 
 	t.logger.Debug("Transcribing", zap.Any("transmissions", transmissions))
 
-	var urls []*url.URL
+	var keys []blob.Key
 
 	for _, transmission := range transmissions {
 		key, err := blob.ParseKey(transmission.Sha256)
 		if err != nil {
 			return 0, fmt.Errorf("unable to parse %q as a blob key: %w", transmission.Sha256, err)
 		}
-		url, err := t.storage.Link(ctx, key, 1*time.Hour)
-		if err != nil {
-			return 0, fmt.Errorf("unable to get a link to %q: %w", key, err)
-		}
-		urls = append(urls, url)
+		keys = append(keys, key)
 	}
 
-	transcriptions, err := t.stt.SpeechToText(ctx, urls)
+	transcriptions, err := t.stt.SpeechToText(ctx, t.storage, keys)
 	if err != nil {
 		return 0, fmt.Errorf("transcription failed: %w", err)
-	} else if len(transcriptions) != len(urls) {
-		return 0, fmt.Errorf("transcriber returned %d strings, but expected %d", len(transcriptions), len(urls))
+	} else if len(transcriptions) != len(keys) {
+		return 0, fmt.Errorf("transcriber returned %d strings, but expected %d", len(transcriptions), len(keys))
 	}
 
 	var models []Transcription
@@ -137,11 +138,14 @@ func (t *transcriber) transcribeOnce(ctx context.Context) (int, error) {
 }
 
 // untranscribedTransmissions will query the database for the next batch of
-// Transmissions that need to be transcribed.
+// Transmissions that need to be transcribed, skipping any whose audio has
+// expired.
 func untranscribedTransmissions(db *gorm.DB, maxBatchSize int) ([]Transmission, error) {
 	var transmissions []Transmission
 	err := db.Joins("LEFT JOIN transcriptions ON transcriptions.transmission_id = transmissions.id").
-		Where("transcriptions.id IS NULL").
+		// Note: There's no audio to transcribe once the retention job has
+		// expired it
+		Where("transcriptions.id IS NULL AND transmissions.audio_expired_at IS NULL").
 		Limit(maxBatchSize).
 		Find(&transmissions).Error
 
@@ -168,11 +172,11 @@ func (w WhisperTranscriber) MaxBatchSize() int {
 	return 1
 }
 
-func (w WhisperTranscriber) SpeechToText(ctx context.Context, urls []*url.URL) ([]string, error) {
+func (w WhisperTranscriber) SpeechToText(ctx context.Context, storage blob.Storage, keys []blob.Key) ([]string, error) {
 	var results []string
 
-	for _, url := range urls {
-		text, err := w.transcribe(ctx, url)
+	for _, key := range keys {
+		text, err := w.transcribe(ctx, storage, key)
 		if err != nil {
 			return nil, err
 		}
@@ -182,16 +186,15 @@ func (w WhisperTranscriber) SpeechToText(ctx context.Context, urls []*url.URL) (
 	return results, nil
 }
 
-func (w WhisperTranscriber) transcribe(ctx context.Context, url *url.URL) (string, error) {
-	logger := w.logger.With(zap.Stringer("url", url))
+func (w WhisperTranscriber) transcribe(ctx context.Context, storage blob.Storage, key blob.Key) (string, error) {
+	logger := w.logger.With(zap.Stringer("key", key))
 	start := time.Now()
 
-	f, cleanup, err := downloadUrl(ctx, logger, url)
+	f, cleanup, err := copyBlobToTempFile(ctx, logger, storage, key)
 	if err != nil {
-		return "", fmt.Errorf("unable to download %s: %w", url, err)
+		return "", fmt.Errorf("unable to read %s: %w", key, err)
 	}
 	defer cleanup()
-	defer f.Close()
 
 	tmp, err := os.MkdirTemp("", "radio-chatter-whisper-tmp*")
 	if err != nil {
@@ -262,59 +265,45 @@ func (w WhisperTranscriber) transcribe(ctx context.Context, url *url.URL) (strin
 	return string(content), nil
 }
 
-func downloadUrl(ctx context.Context, logger *zap.Logger, url *url.URL) (*os.File, func(), error) {
-	dummyCancel := func() {}
-
-	if url.Scheme == "file" {
-		f, err := os.Open(url.Path)
-		// Note: we don't need to do any cleanup because it's an existing file
-		return f, dummyCancel, err
-	}
-
-	f, err := os.CreateTemp("", "radio-chatter-tmp*")
+// copyBlobToTempFile streams a blob into a temporary file so it can be passed
+// to an external program.
+func copyBlobToTempFile(ctx context.Context, logger *zap.Logger, storage blob.Storage, key blob.Key) (*os.File, func(), error) {
+	r, err := storage.Open(ctx, key)
 	if err != nil {
-		return nil, dummyCancel, err
+		return nil, nil, err
 	}
+	defer r.Close()
 
-	logger.Debug("Downloading")
-	response, err := http.Get(url.String())
+	f, err := os.CreateTemp("", key.String()+"-*")
 	if err != nil {
-		return nil, dummyCancel, err
+		return nil, nil, err
 	}
-	defer response.Body.Close()
-	logger.Debug(
-		"Reading response",
-		zap.Int("status", response.StatusCode),
-		zap.Int64("content-length", response.ContentLength),
-		zap.Any("headers", response.Header),
-	)
 
-	if response.StatusCode < 200 || response.StatusCode >= 400 {
-		return nil, dummyCancel, errors.New(response.Status)
+	cleanup := func() {
+		path := f.Name()
+		_ = f.Close()
+		if err := os.Remove(path); err != nil {
+			logger.Warn("Unable to clean up temporary file", zap.String("path", path), zap.Error(err))
+		}
 	}
 
-	bytesWritten, err := io.Copy(f, response.Body)
+	bytesWritten, err := io.Copy(f, r)
 	if err != nil {
-		return nil, dummyCancel, fmt.Errorf("unable to read the response: %s", response.Status)
+		cleanup()
+		return nil, nil, fmt.Errorf("unable to copy the blob to %q: %w", f.Name(), err)
 	}
 
 	if err = f.Sync(); err != nil {
-		return nil, dummyCancel, fmt.Errorf("flushing %q failed: %w", f.Name(), err)
+		cleanup()
+		return nil, nil, fmt.Errorf("flushing %q failed: %w", f.Name(), err)
 	}
 
 	logger.Debug(
-		"Response downloaded",
+		"Copied blob to a temporary file",
 		zap.Int64("bytes-written", bytesWritten),
 		zap.String("tmp", f.Name()),
 	)
 
-	cleanup := func() {
-		path := f.Name()
-		if err := os.Remove(path); err != nil {
-			logger.Warn("Unable to clean up temporary file", zap.String("path", path), zap.Error(err))
-		}
-	}
-
 	return f, cleanup, nil
 }
 
@@ -1,13 +1,12 @@
 package radiochatter
 
 import (
-	"net/url"
 	"os/exec"
 	"testing"
 	"time"
 
 	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
-	"github.com/Michael-F-Bryan/radio-chatter/pkg/on_disk_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
 	"gorm.io/gorm"
@@ -32,6 +31,27 @@ func TestFindUntranscribedTransmissions(t *testing.T) {
 	assert.Equal(t, []Transmission{transmission}, untranscribed)
 }
 
+func TestExpiredTransmissionsAreNotTranscribed(t *testing.T) {
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	stream := Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, db.Save(&stream).Error)
+	chunk := Chunk{StreamID: stream.ID}
+	assert.NoError(t, db.Save(&chunk).Error)
+	expiredAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	expired := Transmission{ChunkID: chunk.ID, AudioExpiredAt: &expiredAt}
+	assert.NoError(t, db.Save(&expired).Error)
+	transmission := Transmission{ChunkID: chunk.ID}
+	assert.NoError(t, db.Save(&transmission).Error)
+
+	untranscribed, err := untranscribedTransmissions(db, 1000)
+
+	assert.NoError(t, err)
+	if assert.Len(t, untranscribed, 1) {
+		assert.Equal(t, transmission.ID, untranscribed[0].ID)
+	}
+}
+
 func TestTranscribeUsingWhisper(t *testing.T) {
 	if testing.Short() {
 		t.SkipNow()
@@ -40,15 +60,14 @@ func TestTranscribeUsingWhisper(t *testing.T) {
 
 	ctx := testContext(t)
 	logger := zaptest.NewLogger(t)
-	storage, err := on_disk_storage.New(logger, t.TempDir())
-	assert.NoError(t, err)
-	defer storage.Close()
+	storage := mem_storage.New()
 	db := testDatabase(ctx, t)
 	recording := testRecording(t)
 	state := ArchiveState{
 		Logger:  logger,
 		Storage: storage,
 		DB:      db,
+		Options: DefaultPreprocessOptions(),
 	}
 	span := audioSpan{Start: 18323800000, End: 22560400000}
 	transmission, err := splitAudio(ctx, state, recording, span, Chunk{})
@@ -56,10 +75,8 @@ func TestTranscribeUsingWhisper(t *testing.T) {
 	w := NewWhisperTranscriber(logger)
 	key, err := blob.ParseKey(transmission.Sha256)
 	assert.NoError(t, err)
-	recordingURL, err := storage.Link(ctx, key, 1*time.Hour)
-	assert.NoError(t, err)
 
-	transcriptions, err := w.SpeechToText(ctx, []*url.URL{recordingURL})
+	transcriptions, err := w.SpeechToText(ctx, storage, []blob.Key{key})
 
 	assert.NoError(t, err)
 	assert.Equal(t, []string{"Okay, out to Verock, over.\n"}, transcriptions)
@@ -0,0 +1,151 @@
+package radiochatter
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"time"
+)
+
+// The format ffmpeg is asked to send to the VAD: mono, 16-bit little-endian
+// PCM.
+const vadSampleRate = 16000
+
+const (
+	// How much audio the VAD looks at in one go.
+	vadFrameLength = 20 * time.Millisecond
+	// A frame needs to be this much louder (in dB) than the noise threshold
+	// to start a transmission, but only needs to stay above the noise
+	// threshold to keep it going. That stops audio hovering around the
+	// threshold from flickering between speech and silence.
+	vadHysteresis = 6.0
+	// Frames where more than this fraction of samples cross zero sound like
+	// hiss (carrier noise, squelch tails, etc.) rather than speech.
+	vadMaxZeroCrossingRate = 0.35
+)
+
+// vad is a simple voice activity detector which uses each frame's energy and
+// zero-crossing rate to decide whether someone is talking.
+type vad struct {
+	SampleRate  int
+	FrameLength time.Duration
+	// Frames quieter than this (in dBFS) are silent.
+	Threshold float64
+	// The extra volume (in dB) needed before silence turns into speech.
+	Hysteresis float64
+	// Frames with a higher zero-crossing rate are treated as noise.
+	MaxZeroCrossingRate float64
+	// How long the audio needs to be quiet before it counts as silence.
+	MinSilence time.Duration
+}
+
+func (o PreprocessOptions) vad() vad {
+	return vad{
+		SampleRate:          vadSampleRate,
+		FrameLength:         vadFrameLength,
+		Threshold:           o.NoiseThreshold,
+		Hysteresis:          vadHysteresis,
+		MaxZeroCrossingRate: vadMaxZeroCrossingRate,
+		MinSilence:          o.MinSilence,
+	}
+}
+
+// Detect reads raw s16le PCM and triggers the same SilenceStart and
+// SilenceEnd callbacks as ffmpeg's silencedetect filter would.
+func (v vad) Detect(r io.Reader, cb PreprocessingCallbacks) error {
+	samplesPerFrame := int(int64(v.SampleRate) * int64(v.FrameLength) / int64(time.Second))
+	if samplesPerFrame < 2 {
+		samplesPerFrame = 2
+	}
+	buffer := make([]byte, 2*samplesPerFrame)
+	samples := make([]int16, samplesPerFrame)
+
+	speaking := false
+	inSilence := false
+	// When the current run of quiet frames started, or -1 while someone is
+	// talking.
+	quietSince := time.Duration(0)
+	position := 0
+
+	for {
+		n, err := io.ReadFull(r, buffer)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			// Note: Any partial frame at the end is ignored
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		for i := range samples {
+			samples[i] = int16(binary.LittleEndian.Uint16(buffer[2*i:]))
+		}
+
+		start := v.timestamp(position)
+		position += n / 2
+		end := v.timestamp(position)
+
+		speaking = v.isSpeech(samples, speaking)
+
+		if speaking {
+			if inSilence {
+				cb.onSilenceEnd(start, start-quietSince)
+				inSilence = false
+			}
+			quietSince = -1
+			continue
+		}
+
+		if quietSince < 0 {
+			quietSince = start
+		}
+		if !inSilence && end-quietSince >= v.MinSilence {
+			cb.onSilenceStart(quietSince)
+			inSilence = true
+		}
+	}
+}
+
+// isSpeech decides whether a frame contains speech, given whether the
+// previous frame did.
+func (v vad) isSpeech(samples []int16, speaking bool) bool {
+	threshold := v.Threshold
+	if !speaking {
+		threshold += v.Hysteresis
+	}
+
+	return frameEnergy(samples) >= threshold && zeroCrossingRate(samples) <= v.MaxZeroCrossingRate
+}
+
+func (v vad) timestamp(samples int) time.Duration {
+	return time.Duration(int64(samples) * int64(time.Second) / int64(v.SampleRate))
+}
+
+// frameEnergy calculates a frame's RMS level in dBFS.
+func frameEnergy(samples []int16) float64 {
+	sum := 0.0
+	for _, sample := range samples {
+		s := float64(sample) / math.MaxInt16
+		sum += s * s
+	}
+
+	rms := math.Sqrt(sum / float64(len(samples)))
+	if rms == 0 {
+		return math.Inf(-1)
+	}
+
+	return 20 * math.Log10(rms)
+}
+
+// zeroCrossingRate is the fraction of neighbouring samples which have
+// different signs.
+func zeroCrossingRate(samples []int16) float64 {
+	crossings := 0
+	for i := 1; i < len(samples); i++ {
+		if (samples[i-1] < 0) != (samples[i] < 0) {
+			crossings++
+		}
+	}
+
+	return float64(crossings) / float64(len(samples)-1)
+}
@@ -0,0 +1,99 @@
+package radiochatter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVADDetectsSpeechButNotHiss(t *testing.T) {
+	var pcm bytes.Buffer
+	writeSilence(&pcm, 2*time.Second)
+	writeTone(&pcm, 3*time.Second, -10)
+	writeSilence(&pcm, 2*time.Second)
+	// A squelch tail is loud, but sounds nothing like speech
+	writeHiss(&pcm, 2*time.Second, -10)
+	writeTone(&pcm, time.Second, -10)
+	var e eventData
+	v := DefaultPreprocessOptions().vad()
+
+	err := v.Detect(&pcm, e.Callbacks(t))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{0, 5 * time.Second}, e.silenceStart)
+	assert.Equal(
+		t,
+		[]endPair{
+			{2 * time.Second, 2 * time.Second},
+			{9 * time.Second, 4 * time.Second},
+		},
+		e.silenceEnd,
+	)
+}
+
+func TestVADIgnoresShortPauses(t *testing.T) {
+	var pcm bytes.Buffer
+	writeTone(&pcm, time.Second, -10)
+	writeSilence(&pcm, 500*time.Millisecond)
+	writeTone(&pcm, time.Second, -10)
+	var e eventData
+	v := DefaultPreprocessOptions().vad()
+
+	err := v.Detect(&pcm, e.Callbacks(t))
+
+	assert.NoError(t, err)
+	assert.Empty(t, e.silenceStart)
+	assert.Empty(t, e.silenceEnd)
+}
+
+func TestVADHysteresis(t *testing.T) {
+	var pcm bytes.Buffer
+	writeSilence(&pcm, time.Second)
+	// Slightly above the threshold, but not loud enough to start speech
+	writeTone(&pcm, time.Second, DefaultNoiseThreshold+3)
+	writeTone(&pcm, time.Second, -10)
+	// Once someone is talking, they can get quieter without being cut off
+	writeTone(&pcm, time.Second, DefaultNoiseThreshold+3)
+	writeSilence(&pcm, time.Second)
+	var e eventData
+	v := DefaultPreprocessOptions().vad()
+
+	err := v.Detect(&pcm, e.Callbacks(t))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{0, 4 * time.Second}, e.silenceStart)
+	assert.Equal(t, []endPair{{2 * time.Second, 2 * time.Second}}, e.silenceEnd)
+}
+
+func writeSamples(pcm *bytes.Buffer, length time.Duration, sample func(i int) float64) {
+	count := int(length.Seconds() * vadSampleRate)
+	for i := 0; i < count; i++ {
+		_ = binary.Write(pcm, binary.LittleEndian, int16(sample(i)*math.MaxInt16))
+	}
+}
+
+func writeSilence(pcm *bytes.Buffer, length time.Duration) {
+	writeSamples(pcm, length, func(int) float64 { return 0 })
+}
+
+// writeTone writes a 300Hz sine wave with the given RMS level in dBFS.
+func writeTone(pcm *bytes.Buffer, length time.Duration, level float64) {
+	amplitude := math.Pow(10, level/20) * math.Sqrt2
+	writeSamples(pcm, length, func(i int) float64 {
+		return amplitude * math.Sin(2*math.Pi*300*float64(i)/vadSampleRate)
+	})
+}
+
+// writeHiss writes uniform white noise with the given RMS level in dBFS.
+func writeHiss(pcm *bytes.Buffer, length time.Duration, level float64) {
+	amplitude := math.Pow(10, level/20) * math.Sqrt(3)
+	random := rand.New(rand.NewSource(42))
+	writeSamples(pcm, length, func(int) float64 {
+		return amplitude * (2*random.Float64() - 1)
+	})
+}
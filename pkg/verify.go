@@ -0,0 +1,321 @@
+package radiochatter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// checkpointInterval is how many blobs get verified between each checkpoint.
+const checkpointInterval = 100
+
+// VerifyOptions control how blobs are verified.
+type VerifyOptions struct {
+	// If set, corrupt blobs will be moved out of blob storage and into this
+	// directory.
+	//
+	// Blobs are content-addressed, so a corrupt blob would otherwise stop the
+	// correct data from being saved again.
+	QuarantineDir string
+	// A file used to keep track of progress. If a verification run is
+	// interrupted, the next run will pick up where it left off.
+	StateFile string
+	// The maximum number of bytes per second to read from blob storage, or 0
+	// for no limit.
+	MaxBytesPerSecond int64
+}
+
+// VerifyReport summarises the result of verifying blob storage.
+type VerifyReport struct {
+	// If the run was resumed, the last key that had already been verified.
+	ResumedAfter string `json:"resumed-after,omitempty"`
+	// How many blobs were re-hashed.
+	Checked int `json:"checked"`
+	// How many bytes were read.
+	BytesChecked int64 `json:"bytes-checked"`
+	// Blobs whose contents no longer match their key.
+	Corrupt []CorruptBlob `json:"corrupt"`
+	// Database rows that reference a blob that doesn't exist.
+	Missing []MissingBlob `json:"missing"`
+}
+
+// CorruptBlob is a blob whose contents don't match its key.
+type CorruptBlob struct {
+	Key string `json:"key"`
+	// The hash of the blob's contents, or empty if the blob couldn't be read.
+	Actual string `json:"actual,omitempty"`
+	// Where the blob was moved to, if it was quarantined.
+	QuarantinedTo string `json:"quarantined-to,omitempty"`
+}
+
+// MissingBlob is a database row which references a blob that doesn't exist.
+type MissingBlob struct {
+	Table  string `json:"table"`
+	ID     uint   `json:"id"`
+	Sha256 string `json:"sha256"`
+}
+
+type verifyState struct {
+	LastKey string `json:"last-key"`
+}
+
+// VerifyBlobs re-hashes every blob in storage to make sure its contents match
+// its key, and checks that every blob referenced by the database exists.
+func VerifyBlobs(
+	ctx context.Context,
+	logger *zap.Logger,
+	db *gorm.DB,
+	storage blob.Storage,
+	opts VerifyOptions,
+) (VerifyReport, error) {
+	var report VerifyReport
+
+	started := time.Now()
+
+	state, err := loadVerifyState(opts.StateFile)
+	if err != nil {
+		return report, err
+	}
+	if state.LastKey != "" {
+		logger.Info("Resuming verification", zap.String("after", state.LastKey))
+		report.ResumedAfter = state.LastKey
+	}
+
+	throttle := newThrottle(ctx, opts.MaxBytesPerSecond)
+	stored := make(map[string]struct{})
+	sinceCheckpoint := 0
+
+	err = storage.List(ctx, func(info blob.Info) error {
+		key := info.Key.String()
+		stored[key] = struct{}{}
+
+		if key <= state.LastKey {
+			// Already verified by a previous run
+			return nil
+		}
+
+		actual, bytesRead, err := hashBlob(ctx, storage, info.Key, throttle)
+		if errors.Is(err, blob.ErrNotFound) {
+			// Deleted while we were iterating
+			delete(stored, key)
+			return nil
+		} else if errors.Is(err, blob.ErrCorrupt) {
+			// Note: The storage layer noticed the blob is damaged (e.g. it
+			// failed decryption), so there's nothing we could quarantine
+			logger.Warn("Unreadable blob", zap.String("key", key), zap.Error(err))
+			report.Checked++
+			report.BytesChecked += bytesRead
+			report.Corrupt = append(report.Corrupt, CorruptBlob{Key: key})
+			state.LastKey = key
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		report.Checked++
+		report.BytesChecked += bytesRead
+
+		if actual != key {
+			logger.Warn("Corrupt blob", zap.String("key", key), zap.String("actual", actual))
+			corrupt := CorruptBlob{Key: key, Actual: actual}
+
+			if opts.QuarantineDir != "" {
+				dest, err := quarantine(ctx, storage, info.Key, opts.QuarantineDir)
+				if err != nil {
+					return err
+				}
+				corrupt.QuarantinedTo = dest
+				delete(stored, key)
+				logger.Info("Quarantined blob", zap.String("key", key), zap.String("path", dest))
+			}
+
+			report.Corrupt = append(report.Corrupt, corrupt)
+		}
+
+		state.LastKey = key
+		sinceCheckpoint++
+		if sinceCheckpoint >= checkpointInterval {
+			sinceCheckpoint = 0
+			return saveVerifyState(opts.StateFile, state)
+		}
+
+		return nil
+	})
+	if err != nil {
+		// Save our progress so the next run can resume
+		if saveErr := saveVerifyState(opts.StateFile, state); saveErr != nil {
+			logger.Warn("Unable to save verification progress", zap.Error(saveErr))
+		}
+		return report, fmt.Errorf("verification failed: %w", err)
+	}
+
+	// Note: Only rows created before we started listing are checked. Newer
+	// rows may reference blobs that were saved after we listed that part of
+	// storage.
+	missing, err := missingBlobs(db.WithContext(ctx), stored, started)
+	if err != nil {
+		return report, err
+	}
+	report.Missing = missing
+
+	// We made it to the end, so the next run should start from scratch
+	if opts.StateFile != "" {
+		if err := os.Remove(opts.StateFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return report, fmt.Errorf("unable to remove %q: %w", opts.StateFile, err)
+		}
+	}
+
+	return report, nil
+}
+
+func hashBlob(ctx context.Context, storage blob.Storage, key blob.Key, t *throttle) (string, int64, error) {
+	r, err := storage.Open(ctx, key)
+	if err != nil {
+		return "", 0, err
+	}
+	defer r.Close()
+
+	hasher := sha256.New()
+	bytesRead, err := io.Copy(hasher, t.Reader(r))
+	if err != nil {
+		return "", bytesRead, fmt.Errorf("unable to read %s: %w", key, err)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), bytesRead, nil
+}
+
+// quarantine moves a blob out of storage and into a directory on disk.
+func quarantine(ctx context.Context, storage blob.Storage, key blob.Key, dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0766); err != nil {
+		return "", fmt.Errorf("unable to create %s/: %w", dir, err)
+	}
+
+	dest := filepath.Join(dir, key.String())
+
+	r, err := storage.Open(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return "", fmt.Errorf("unable to create %q: %w", dest, err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return "", fmt.Errorf("unable to copy %s to %q: %w", key, dest, err)
+	}
+	if err := f.Sync(); err != nil {
+		return "", fmt.Errorf("flushing %q failed: %w", dest, err)
+	}
+
+	if err := storage.Delete(ctx, key); err != nil {
+		return "", fmt.Errorf("unable to remove %s from storage: %w", key, err)
+	}
+
+	return dest, nil
+}
+
+// missingBlobs finds any rows created before a particular time which reference
+// blobs that aren't in storage. Rows whose audio has expired are skipped.
+func missingBlobs(db *gorm.DB, stored map[string]struct{}, createdBefore time.Time) ([]MissingBlob, error) {
+	var missing []MissingBlob
+
+	type row struct {
+		ID     uint
+		Sha256 string
+	}
+
+	tables := []struct {
+		name   string
+		model  any
+		column string
+		// Rows may leave this column empty (e.g. chunks without raw audio).
+		optional bool
+	}{
+		{"chunks", &Chunk{}, "sha256", false},
+		{"chunks", &Chunk{}, "raw_sha256", true},
+		{"transmissions", &Transmission{}, "sha256", false},
+	}
+
+	for _, table := range tables {
+		query := db.Model(table.model).
+			Select("id", table.column+" AS sha256").
+			Where("created_at < ? AND audio_expired_at IS NULL", createdBefore)
+		if table.optional {
+			query = query.Where(table.column + " <> ''")
+		}
+
+		var rows []row
+		err := query.
+			FindInBatches(&rows, 1000, func(tx *gorm.DB, batch int) error {
+				for _, r := range rows {
+					if _, ok := stored[r.Sha256]; !ok {
+						missing = append(missing, MissingBlob{Table: table.name, ID: r.ID, Sha256: r.Sha256})
+					}
+				}
+				return nil
+			}).
+			Error
+		if err != nil {
+			return nil, fmt.Errorf("unable to check the %s table: %w", table.name, err)
+		}
+	}
+
+	return missing, nil
+}
+
+func loadVerifyState(filename string) (verifyState, error) {
+	var state verifyState
+
+	if filename == "" {
+		return state, nil
+	}
+
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	} else if err != nil {
+		return state, fmt.Errorf("unable to read %q: %w", filename, err)
+	}
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("unable to parse %q: %w", filename, err)
+	}
+
+	return state, nil
+}
+
+func saveVerifyState(filename string, state verifyState) error {
+	if filename == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	// Note: write to a temporary file and rename it so a crash can't leave us
+	// with a half-written state file
+	tmp := filename + ".tmp"
+	if err := os.WriteFile(tmp, data, 0666); err != nil {
+		return fmt.Errorf("unable to save %q: %w", tmp, err)
+	}
+
+	return os.Rename(tmp, filename)
+}
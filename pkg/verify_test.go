@@ -0,0 +1,130 @@
+package radiochatter
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/blob"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/encrypted_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/mem_storage"
+	"github.com/Michael-F-Bryan/radio-chatter/pkg/on_disk_storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestVerifyFindsCorruptAndMissingBlobs(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	dir := t.TempDir()
+	storage, err := on_disk_storage.New(logger, dir)
+	assert.NoError(t, err)
+	defer storage.Close()
+	stream := Stream{DisplayName: "Test", Url: "..."}
+	assert.NoError(t, db.Save(&stream).Error)
+	goodKey := storeString(ctx, t, storage, "good")
+	chunk := Chunk{StreamID: stream.ID, Sha256: goodKey.String()}
+	assert.NoError(t, db.Save(&chunk).Error)
+	missingKey := blob.KeyForBytes([]byte("missing"))
+	transmission := Transmission{ChunkID: chunk.ID, Sha256: missingKey.String()}
+	assert.NoError(t, db.Save(&transmission).Error)
+	corruptKey := storeString(ctx, t, storage, "corrupt")
+	corruptPath := onDiskPath(dir, corruptKey)
+	assert.NoError(t, os.WriteFile(corruptPath, []byte("bit rot"), 0666))
+	quarantineDir := t.TempDir()
+	// Make sure the rows were created before verification started
+	time.Sleep(10 * time.Millisecond)
+
+	report, err := VerifyBlobs(ctx, logger, db, storage, VerifyOptions{QuarantineDir: quarantineDir})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Checked)
+	assert.Equal(t, []CorruptBlob{
+		{
+			Key:           corruptKey.String(),
+			Actual:        blob.KeyForBytes([]byte("bit rot")).String(),
+			QuarantinedTo: filepath.Join(quarantineDir, corruptKey.String()),
+		},
+	}, report.Corrupt)
+	assert.Equal(t, []MissingBlob{
+		{Table: "transmissions", ID: transmission.ID, Sha256: missingKey.String()},
+	}, report.Missing)
+	assert.NoFileExists(t, corruptPath)
+	assert.FileExists(t, filepath.Join(quarantineDir, corruptKey.String()))
+}
+
+func TestVerifyResumesFromStateFile(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	storage, err := on_disk_storage.New(logger, t.TempDir())
+	assert.NoError(t, err)
+	defer storage.Close()
+	first := storeString(ctx, t, storage, "first")
+	second := storeString(ctx, t, storage, "second")
+	lower, higher := first.String(), second.String()
+	if higher < lower {
+		lower, higher = higher, lower
+	}
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	assert.NoError(t, saveVerifyState(stateFile, verifyState{LastKey: lower}))
+
+	report, err := VerifyBlobs(ctx, logger, db, storage, VerifyOptions{StateFile: stateFile})
+
+	assert.NoError(t, err)
+	assert.Equal(t, lower, report.ResumedAfter)
+	assert.Equal(t, 1, report.Checked)
+	assert.Empty(t, report.Missing)
+	// The state file is removed once we reach the end
+	assert.NoFileExists(t, stateFile)
+}
+
+func TestVerifyReportsBlobsThatFailDecryption(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := testContext(t)
+	db := testDatabase(ctx, t)
+	inner := mem_storage.New()
+	keyring, err := encrypted_storage.ParseKeyring("test:" + base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	assert.NoError(t, err)
+	storage, err := encrypted_storage.New(logger, inner, encrypted_storage.Config{Keyring: keyring})
+	assert.NoError(t, err)
+	good := storeString(ctx, t, storage, "good")
+	tampered := storeString(ctx, t, storage, "tampered")
+	raw, _ := inner.Get(tampered)
+	raw[len(raw)-1] ^= 0xFF
+	assert.NoError(t, inner.StoreAt(ctx, tampered, bytes.NewReader(raw)))
+
+	report, err := VerifyBlobs(ctx, logger, db, storage, VerifyOptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Checked)
+	assert.Equal(t, []CorruptBlob{{Key: tampered.String()}}, report.Corrupt)
+	assertBlobExists(ctx, t, storage, good)
+}
+
+func TestThrottleLimitsReadRate(t *testing.T) {
+	ctx := testContext(t)
+	throttle := newThrottle(ctx, 1000)
+	data := make([]byte, 100)
+
+	started := time.Now()
+	n, err := throttle.Reader(&zeroReader{}).Read(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100, n)
+	assert.GreaterOrEqual(t, time.Since(started), 90*time.Millisecond)
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) { return len(p), nil }
+
+// onDiskPath gets the path on_disk_storage saves a blob to.
+func onDiskPath(root string, key blob.Key) string {
+	hex := key.String()
+	return filepath.Join(root, hex[0:2], hex[2:4], hex)
+}